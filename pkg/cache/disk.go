@@ -0,0 +1,274 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	diskFileExt   = ".cache"
+	diskTmpPrefix = ".tmp-"
+	diskTmpMaxAge = time.Hour
+)
+
+var diskMagic = []byte("TCGC")
+
+// Disk is a cache persisted in a directory, one file per key. Files are
+// written atomically through a rename so several processes can share the
+// same directory. When maxBytes is exceeded the least recently used files
+// are removed.
+type Disk struct {
+	dir      string
+	maxBytes int64
+	now      func() time.Time
+
+	mu   sync.Mutex
+	size int64
+}
+
+// NewDisk creates the cache directory if needed. A non positive maxBytes
+// means no bound.
+func NewDisk(dir string, maxBytes int64) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create cache dir: %w", err)
+	}
+
+	return &Disk{
+		dir:      dir,
+		maxBytes: maxBytes,
+		now:      time.Now,
+		size:     -1,
+	}, nil
+}
+
+func (d *Disk) Get(key string) ([]byte, bool) {
+	path := d.path(key)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	storedKey, value, expiresAt, err := decodeDiskEntry(data)
+	if err != nil || storedKey != key {
+		return nil, false
+	}
+
+	now := d.now()
+	if !expiresAt.IsZero() && !now.Before(expiresAt) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	// the modification time tracks recent use for the eviction
+	_ = os.Chtimes(path, now, now)
+
+	return value, true
+}
+
+// Set stores value for ttl. A non positive ttl never expires. Write errors
+// are ignored, the entry is simply missing on the next Get.
+func (d *Disk) Set(key string, value []byte, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = d.now().Add(ttl)
+	}
+
+	path := d.path(key)
+
+	// a replaced entry no longer counts, revalidations store the same keys
+	// again and again
+	var replaced int64
+	if d.maxBytes > 0 {
+		if info, err := os.Stat(path); err == nil {
+			replaced = info.Size()
+		}
+	}
+
+	data := encodeDiskEntry(key, value, expiresAt)
+	if err := d.writeFile(path, data); err != nil {
+		return
+	}
+
+	if d.maxBytes <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size >= 0 {
+		d.size += int64(len(data)) - replaced
+	}
+	if d.size < 0 || d.size > d.maxBytes {
+		_ = d.evict()
+	}
+}
+
+func (d *Disk) Delete(key string) {
+	_ = os.Remove(d.path(key))
+}
+
+// Prune removes expired entries and stale temporary files, then evicts
+// entries until the cache fits in maxBytes.
+func (d *Disk) Prune() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.evict()
+}
+
+func (d *Disk) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+diskFileExt)
+}
+
+func (d *Disk) writeFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(d.dir, diskTmpPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+type diskFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// evict must be called with d.mu held. Files removed concurrently by other
+// processes are ignored.
+func (d *Disk) evict() error {
+	dirEntries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return fmt.Errorf("read cache dir: %w", err)
+	}
+
+	now := d.now()
+	var (
+		files []diskFile
+		total int64
+	)
+	for _, dirEntry := range dirEntries {
+		info, err := dirEntry.Info()
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+
+		path := filepath.Join(d.dir, dirEntry.Name())
+		switch {
+		case strings.HasPrefix(dirEntry.Name(), diskTmpPrefix):
+			if now.Sub(info.ModTime()) > diskTmpMaxAge {
+				_ = os.Remove(path)
+			}
+			continue
+		case !strings.HasSuffix(dirEntry.Name(), diskFileExt):
+			continue
+		case d.expired(path):
+			_ = os.Remove(path)
+			continue
+		}
+
+		files = append(files, diskFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+	}
+
+	if d.maxBytes > 0 && total > d.maxBytes {
+		slices.SortFunc(files, func(a, b diskFile) int {
+			return a.modTime.Compare(b.modTime)
+		})
+
+		// evict below the bound so the next writes do not trigger a scan
+		target := d.maxBytes - d.maxBytes/10
+		for _, file := range files {
+			if total <= target {
+				break
+			}
+			_ = os.Remove(file.path)
+			total -= file.size
+		}
+	}
+
+	d.size = total
+
+	return nil
+}
+
+// expired only reads the header of the file at path. Unreadable entries are
+// reported as expired so they get cleaned up.
+func (d *Disk) expired(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	header := make([]byte, len(diskMagic)+12)
+	if _, err := io.ReadFull(file, header); err != nil || !bytes.Equal(header[:len(diskMagic)], diskMagic) {
+		return true
+	}
+
+	expires := int64(binary.BigEndian.Uint64(header[len(diskMagic):]))
+
+	return expires != 0 && !d.now().Before(time.Unix(0, expires))
+}
+
+// A disk entry is the magic, the expiry in unix nanoseconds (0 for none),
+// the key length and the key, followed by the value.
+func encodeDiskEntry(key string, value []byte, expiresAt time.Time) []byte {
+	var expires int64
+	if !expiresAt.IsZero() {
+		expires = expiresAt.UnixNano()
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(diskMagic)+12+len(key)+len(value)))
+	buf.Write(diskMagic)
+	_ = binary.Write(buf, binary.BigEndian, expires)
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(key)))
+	buf.WriteString(key)
+	buf.Write(value)
+
+	return buf.Bytes()
+}
+
+func decodeDiskEntry(data []byte) (string, []byte, time.Time, error) {
+	header := len(diskMagic) + 12
+	if len(data) < header || !bytes.Equal(data[:len(diskMagic)], diskMagic) {
+		return "", nil, time.Time{}, errors.New("invalid cache entry")
+	}
+
+	expires := int64(binary.BigEndian.Uint64(data[len(diskMagic):]))
+	keyLen := int(binary.BigEndian.Uint32(data[len(diskMagic)+8:]))
+	if len(data) < header+keyLen {
+		return "", nil, time.Time{}, errors.New("invalid cache entry")
+	}
+
+	var expiresAt time.Time
+	if expires != 0 {
+		expiresAt = time.Unix(0, expires)
+	}
+
+	return string(data[header : header+keyLen]), data[header+keyLen:], expiresAt, nil
+}
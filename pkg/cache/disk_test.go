@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskGetSet(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	d.Set("https://api.tcgdex.net/v2/en/types", []byte(`["Fire"]`), time.Minute)
+
+	value, ok := d.Get("https://api.tcgdex.net/v2/en/types")
+	assert.True(t, ok)
+	assert.Equal(t, []byte(`["Fire"]`), value)
+
+	_, ok = d.Get("https://api.tcgdex.net/v2/en/rarities")
+	assert.False(t, ok)
+}
+
+func TestDiskPersists(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := NewDisk(dir, 0)
+	assert.NoError(t, err)
+	d.Set("a", []byte("1"), 0)
+
+	d, err = NewDisk(dir, 0)
+	assert.NoError(t, err)
+	value, ok := d.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+}
+
+func TestDiskExpires(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	d.now = func() time.Time { return now }
+	d.Set("a", []byte("1"), time.Minute)
+
+	now = now.Add(time.Minute)
+	_, ok := d.Get("a")
+	assert.False(t, ok)
+	assert.NoFileExists(t, d.path("a"))
+}
+
+func TestDiskDelete(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	d.Set("a", []byte("1"), 0)
+	d.Delete("a")
+
+	_, ok := d.Get("a")
+	assert.False(t, ok)
+}
+
+func TestDiskEvictsLeastRecentlyUsed(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 1000)
+	assert.NoError(t, err)
+
+	value := make([]byte, 200)
+	for i := range 4 {
+		d.Set(fmt.Sprint(i), value, 0)
+		old := time.Now().Add(time.Duration(i-10) * time.Minute)
+		assert.NoError(t, os.Chtimes(d.path(fmt.Sprint(i)), old, old))
+	}
+	d.Set("4", value, 0)
+	d.Set("5", value, 0)
+
+	_, ok := d.Get("0")
+	assert.False(t, ok)
+	_, ok = d.Get("5")
+	assert.True(t, ok)
+
+	var total int64
+	files, err := os.ReadDir(d.dir)
+	assert.NoError(t, err)
+	for _, file := range files {
+		info, err := file.Info()
+		assert.NoError(t, err)
+		total += info.Size()
+	}
+	assert.LessOrEqual(t, total, int64(1000))
+}
+
+func TestDiskSetReplaceKeepsSize(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 1<<20)
+	assert.NoError(t, err)
+
+	d.Set("a", []byte("1"), 0)
+	size := d.size
+	for range 10 {
+		d.Set("a", []byte("2"), time.Minute)
+	}
+
+	info, err := os.Stat(d.path("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, size, d.size)
+	assert.Equal(t, info.Size(), d.size)
+}
+
+func TestDiskPruneRemovesExpired(t *testing.T) {
+	d, err := NewDisk(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	d.Set("a", []byte("1"), time.Nanosecond)
+	d.Set("b", []byte("2"), 0)
+	time.Sleep(time.Millisecond)
+
+	assert.NoError(t, d.Prune())
+	assert.NoFileExists(t, d.path("a"))
+	assert.FileExists(t, d.path("b"))
+}
+
+func TestDiskConcurrentSet(t *testing.T) {
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d, err := NewDisk(dir, 0)
+			assert.NoError(t, err)
+			for range 20 {
+				d.Set("card", []byte(fmt.Sprintf("value-%d", i)), 0)
+				value, ok := d.Get("card")
+				assert.True(t, ok)
+				assert.Regexp(t, `^value-\d$`, string(value))
+			}
+		}()
+	}
+	wg.Wait()
+
+	tmp, err := filepath.Glob(filepath.Join(dir, diskTmpPrefix+"*"))
+	assert.NoError(t, err)
+	assert.Empty(t, tmp)
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUGetSet(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Minute)
+
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+	c.Get("a")
+	c.Set("c", []byte("3"), time.Minute)
+
+	assert.Equal(t, 2, c.Len())
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+}
+
+func TestLRUExpires(t *testing.T) {
+	now := time.Now()
+	c := NewLRU(0)
+	c.now = func() time.Time { return now }
+	c.Set("a", []byte("1"), time.Minute)
+
+	now = now.Add(time.Minute)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLRUDelete(t *testing.T) {
+	c := NewLRU(0)
+	c.Set("a", []byte("1"), 0)
+	c.Delete("a")
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,164 @@
+// Package graphql is a client for the TCGdex GraphQL endpoint, which returns
+// only the fields selected by the query.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultEndpoint = "https://api.tcgdex.net/v2/graphql"
+	DefaultTimeout  = 10 * time.Second
+
+	maxErrorBodySize = 64 << 10
+)
+
+// Client sends queries to a GraphQL endpoint. It is safe for concurrent use.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	userAgent  string
+	headers    http.Header
+}
+
+// Option configures a client created with NewClient.
+type Option func(*Client)
+
+// WithEndpoint sets the URL of the GraphQL endpoint.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for every request.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeaders adds headers sent with every request.
+func WithHeaders(headers http.Header) Option {
+	return func(c *Client) {
+		for key, values := range headers {
+			for _, value := range values {
+				c.headers.Add(key, value)
+			}
+		}
+	}
+}
+
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		endpoint: DefaultEndpoint,
+		headers:  make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	return c
+}
+
+// Request is a GraphQL operation with its variables.
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors Errors          `json:"errors"`
+}
+
+// Do sends req and decodes the data of the response into data. When the
+// response carries GraphQL errors they are returned as Errors, after the
+// partial data has been decoded.
+func (c *Client) Do(ctx context.Context, req Request, data any) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encode request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	for key, values := range c.headers {
+		httpReq.Header[key] = values
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		httpReq.Header.Set("User-Agent", c.userAgent)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	return decodeResponse(httpResp, data)
+}
+
+// decodeResponse accepts GraphQL errors with any status, servers commonly
+// answer 400 to invalid queries with a regular errors body.
+func decodeResponse(httpResp *http.Response, data any) error {
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+
+	var resp response
+	decodeErr := json.Unmarshal(body, &resp)
+	if httpResp.StatusCode != http.StatusOK && (decodeErr != nil || len(resp.Errors) == 0) {
+		return newHTTPError(httpResp, body)
+	}
+	if decodeErr != nil {
+		return fmt.Errorf("decode response body: %w", decodeErr)
+	}
+
+	if data != nil && len(resp.Data) > 0 && !bytes.Equal(resp.Data, []byte("null")) {
+		if err := json.Unmarshal(resp.Data, data); err != nil {
+			return fmt.Errorf("decode response data: %w", err)
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+
+	return nil
+}
+
+func newHTTPError(httpResp *http.Response, body []byte) *HTTPError {
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+
+	return &HTTPError{
+		Status: httpResp.StatusCode,
+		Title:  http.StatusText(httpResp.StatusCode),
+		Body:   body,
+	}
+}
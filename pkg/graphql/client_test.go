@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedRequest struct {
+	method  string
+	header  http.Header
+	payload Request
+}
+
+// newGraphQLServer answers every request with status and body and records
+// the last request in recorded.
+func newGraphQLServer(t *testing.T, recorded *recordedRequest, status int, body string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.method = r.Method
+		recorded.header = r.Header.Clone()
+		recorded.payload = Request{}
+		_ = json.NewDecoder(r.Body).Decode(&recorded.payload)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDo(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{"card":{"id":"swsh3-136","name":"Furret"}}}`)
+
+	c := NewClient(
+		WithEndpoint(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithUserAgent("my-app/1.0"),
+		WithHeaders(http.Header{"X-Api-Key": {"secret"}}),
+	)
+
+	var data struct {
+		Card struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"card"`
+	}
+	err := c.Do(context.Background(), Request{
+		Query:     "query Card($id: ID!) { card(id: $id) { id name } }",
+		Variables: map[string]any{"id": "swsh3-136"},
+	}, &data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Furret", data.Card.Name)
+
+	assert.Equal(t, http.MethodPost, recorded.method)
+	assert.Equal(t, "application/json", recorded.header.Get("Content-Type"))
+	assert.Equal(t, "my-app/1.0", recorded.header.Get("User-Agent"))
+	assert.Equal(t, "secret", recorded.header.Get("X-Api-Key"))
+	assert.Equal(t, map[string]any{"id": "swsh3-136"}, recorded.payload.Variables)
+}
+
+func TestDoGraphQLErrors(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{
+		"data": {"card": {"id": "swsh3-136"}},
+		"errors": [
+			{"message": "Cannot query field \"foo\"", "locations": [{"line": 1, "column": 12}], "path": ["card", "foo"], "extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}},
+			{"message": "second"}
+		]
+	}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	var data struct {
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	}
+	err := c.Do(context.Background(), Request{Query: "{ card { id foo } }"}, &data)
+
+	var gqlErrs Errors
+	assert.ErrorAs(t, err, &gqlErrs)
+	assert.Len(t, gqlErrs, 2)
+	assert.Equal(t, `graphql: Cannot query field "foo" (card.foo); graphql: second`, err.Error())
+
+	var gqlErr Error
+	assert.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "GRAPHQL_VALIDATION_FAILED", gqlErr.Code())
+	assert.Equal(t, []Location{{Line: 1, Column: 12}}, gqlErr.Locations)
+
+	// partial data is still decoded
+	assert.Equal(t, "swsh3-136", data.Card.ID)
+}
+
+func TestDoGraphQLErrorsWithBadRequest(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusBadRequest, `{"errors":[{"message":"Syntax Error"}]}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	err := c.Do(context.Background(), Request{Query: "{"}, nil)
+
+	var gqlErrs Errors
+	assert.ErrorAs(t, err, &gqlErrs)
+	assert.Equal(t, "Syntax Error", gqlErrs[0].Message)
+}
+
+func TestDoHTTPError(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusBadGateway, `<html>Bad Gateway</html>`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	err := c.Do(context.Background(), Request{Query: "{ sets { id } }"}, nil)
+	assert.ErrorIs(t, err, ErrServer)
+
+	var httpErr *HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadGateway, httpErr.Status)
+	assert.Equal(t, "graphql: 502 Bad Gateway", httpErr.Error())
+	assert.Equal(t, []byte(`<html>Bad Gateway</html>`), httpErr.Body)
+}
+
+func TestDoCanceled(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.Do(ctx, Request{Query: "{ sets { id } }"}, nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,95 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrNotFound    = errors.New("graphql: not found")
+	ErrRateLimited = errors.New("graphql: rate limited")
+	ErrServer      = errors.New("graphql: server error")
+)
+
+// Location points at the part of the query an error relates to.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error is one entry of the errors list of a GraphQL response.
+type Error struct {
+	Message    string         `json:"message"`
+	Locations  []Location     `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+func (e Error) Error() string {
+	if len(e.Path) == 0 {
+		return "graphql: " + e.Message
+	}
+
+	path := make([]string, len(e.Path))
+	for i, segment := range e.Path {
+		path[i] = fmt.Sprint(segment)
+	}
+
+	return fmt.Sprintf("graphql: %s (%s)", e.Message, strings.Join(path, "."))
+}
+
+// Code returns the code extension of the error, if any.
+func (e Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// Errors is returned when a response carries GraphQL errors. Each Error can
+// be reached with errors.As.
+type Errors []Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// HTTPError is returned when the endpoint answers with a non 200 status and
+// no GraphQL errors. It matches ErrNotFound, ErrRateLimited and ErrServer
+// through errors.Is.
+type HTTPError struct {
+	Status int
+	Title  string
+	Body   []byte
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("graphql: %d %s", e.Status, e.Title)
+}
+
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrServer:
+		return e.Status >= http.StatusInternalServerError
+	}
+
+	return false
+}
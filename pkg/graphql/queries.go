@@ -0,0 +1,154 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// Fields selected by the typed queries when called without fields. Fields use
+// the GraphQL selection syntax, e.g. "id", "name" or "set { id name }".
+const (
+	DefaultCardFields = "id localId name image category illustrator rarity hp types stage evolveFrom " +
+		"description effect regulationMark retreat trainerType energyType " +
+		"set { id name logo symbol } variants { firstEdition holo normal reverse wPromo } " +
+		"attacks { cost name effect damage } weaknesses { type value } resistances { type value } " +
+		"legal { standard expanded }"
+	DefaultSetFields = "id name logo symbol releaseDate tcgOnline cardCount { firstEd holo normal official reverse total } " +
+		"serie { id name logo } legal { standard expanded } cards { id localId name image }"
+	DefaultSerieFields = "id name logo sets { id name logo symbol cardCount { official total } }"
+)
+
+const (
+	cardQuery   = "query Card($id: ID!) { card(id: $id) { %s } }"
+	cardsQuery  = "query Cards($filters: CardsFilters, $pagination: Pagination) { cards(filters: $filters, pagination: $pagination) { %s } }"
+	setQuery    = "query Set($id: ID!) { set(id: $id) { %s } }"
+	setsQuery   = "query Sets($filters: SetFilters, $pagination: Pagination) { sets(filters: $filters, pagination: $pagination) { %s } }"
+	serieQuery  = "query Serie($id: ID!) { serie(id: $id) { %s } }"
+	seriesQuery = "query Series($filters: SerieFilters, $pagination: Pagination) { series(filters: $filters, pagination: $pagination) { %s } }"
+)
+
+// Pagination selects a page of a list query, Count being the page size.
+type Pagination struct {
+	Page  int `json:"page"`
+	Count int `json:"count"`
+}
+
+type CardFilters struct {
+	ID             string `json:"id,omitempty"`
+	LocalID        string `json:"localId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Category       string `json:"category,omitempty"`
+	Illustrator    string `json:"illustrator,omitempty"`
+	Rarity         string `json:"rarity,omitempty"`
+	Stage          string `json:"stage,omitempty"`
+	EvolveFrom     string `json:"evolveFrom,omitempty"`
+	Suffix         string `json:"suffix,omitempty"`
+	RegulationMark string `json:"regulationMark,omitempty"`
+	TrainerType    string `json:"trainerType,omitempty"`
+	EnergyType     string `json:"energyType,omitempty"`
+	HP             int    `json:"hp,omitempty"`
+	Retreat        *int   `json:"retreat,omitempty"`
+	DexID          int    `json:"dexId,omitempty"`
+}
+
+type SetFilters struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type SerieFilters struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Card returns ErrNotFound when no card has the id.
+func (c *Client) Card(ctx context.Context, id string, fields ...string) (*model.Card, error) {
+	return single[model.Card](ctx, c, "card", cardQuery, DefaultCardFields, fields, map[string]any{"id": id})
+}
+
+func (c *Client) Cards(ctx context.Context, filters CardFilters, pagination *Pagination, fields ...string) ([]model.Card, error) {
+	return list[model.Card](ctx, c, "cards", cardsQuery, DefaultCardFields, fields, listVariables(filters, pagination))
+}
+
+// Set returns ErrNotFound when no set has the id.
+func (c *Client) Set(ctx context.Context, id string, fields ...string) (*model.Set, error) {
+	return single[model.Set](ctx, c, "set", setQuery, DefaultSetFields, fields, map[string]any{"id": id})
+}
+
+func (c *Client) Sets(ctx context.Context, filters SetFilters, pagination *Pagination, fields ...string) ([]model.Set, error) {
+	return list[model.Set](ctx, c, "sets", setsQuery, DefaultSetFields, fields, listVariables(filters, pagination))
+}
+
+// Serie returns ErrNotFound when no serie has the id.
+func (c *Client) Serie(ctx context.Context, id string, fields ...string) (*model.Serie, error) {
+	return single[model.Serie](ctx, c, "serie", serieQuery, DefaultSerieFields, fields, map[string]any{"id": id})
+}
+
+func (c *Client) Series(ctx context.Context, filters SerieFilters, pagination *Pagination, fields ...string) ([]model.Serie, error) {
+	return list[model.Serie](ctx, c, "series", seriesQuery, DefaultSerieFields, fields, listVariables(filters, pagination))
+}
+
+func listVariables(filters any, pagination *Pagination) map[string]any {
+	variables := map[string]any{"filters": filters}
+	if pagination != nil {
+		variables["pagination"] = pagination
+	}
+
+	return variables
+}
+
+func single[T any](ctx context.Context, c *Client, field, query, defaultFields string, fields []string, variables map[string]any) (*T, error) {
+	var value *T
+	if err := c.field(ctx, newRequest(query, defaultFields, fields, variables), field, &value); err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("%s %v: %w", field, variables["id"], ErrNotFound)
+	}
+
+	return value, nil
+}
+
+func list[T any](ctx context.Context, c *Client, field, query, defaultFields string, fields []string, variables map[string]any) ([]T, error) {
+	var values []T
+	if err := c.field(ctx, newRequest(query, defaultFields, fields, variables), field, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// field sends req and decodes the top level field of the data into target.
+func (c *Client) field(ctx context.Context, req Request, field string, target any) error {
+	var data map[string]json.RawMessage
+	if err := c.Do(ctx, req, &data); err != nil {
+		return err
+	}
+
+	raw, ok := data[field]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("decode %s: %w", field, err)
+	}
+
+	return nil
+}
+
+func newRequest(query, defaultFields string, fields []string, variables map[string]any) Request {
+	selection := defaultFields
+	if len(fields) > 0 {
+		selection = strings.Join(fields, " ")
+	}
+
+	return Request{
+		Query:     fmt.Sprintf(query, selection),
+		Variables: variables,
+	}
+}
@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCard(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{"card":{
+		"id": "swsh3-136", "localId": "136", "name": "Furret", "hp": 110, "types": ["Colorless"],
+		"set": {"id": "swsh3", "name": "Darkness Ablaze"},
+		"attacks": [{"cost": ["Colorless"], "name": "Feelin' Fine", "damage": 0}]
+	}}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	card, err := c.Card(context.Background(), "swsh3-136", "id", "localId", "name", "hp", "types", "set { id name }", "attacks { cost name damage }")
+	assert.NoError(t, err)
+	assert.Equal(t, "Furret", card.Name)
+	assert.Equal(t, 110, card.Hp)
+	assert.Equal(t, "swsh3", card.Set.ID)
+	assert.Equal(t, "Feelin' Fine", card.Attacks[0].Name)
+
+	assert.Equal(t, "query Card($id: ID!) { card(id: $id) { id localId name hp types set { id name } attacks { cost name damage } } }", recorded.payload.Query)
+	assert.Equal(t, map[string]any{"id": "swsh3-136"}, recorded.payload.Variables)
+}
+
+func TestCardDefaultFields(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{"card":{"id":"swsh3-136"}}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	_, err := c.Card(context.Background(), "swsh3-136")
+	assert.NoError(t, err)
+	assert.Contains(t, recorded.payload.Query, DefaultCardFields)
+}
+
+func TestCardNotFound(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{"card":null}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	card, err := c.Card(context.Background(), "swsh3-999")
+	assert.Nil(t, card)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCards(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{"cards":[{"id":"swsh3-25","name":"Charizard"},{"id":"swsh3-26","name":"Charmander"}]}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	retreat := 2
+	cards, err := c.Cards(context.Background(), CardFilters{Name: "char", Retreat: &retreat}, &Pagination{Page: 1, Count: 10}, "id", "name")
+	assert.NoError(t, err)
+	assert.Len(t, cards, 2)
+	assert.Equal(t, "Charmander", cards[1].Name)
+
+	assert.Equal(t, "query Cards($filters: CardsFilters, $pagination: Pagination) { cards(filters: $filters, pagination: $pagination) { id name } }", recorded.payload.Query)
+	assert.Equal(t, map[string]any{
+		"filters":    map[string]any{"name": "char", "retreat": float64(2)},
+		"pagination": map[string]any{"page": float64(1), "count": float64(10)},
+	}, recorded.payload.Variables)
+}
+
+func TestSetAndSeries(t *testing.T) {
+	var recorded recordedRequest
+	srv := newGraphQLServer(t, &recorded, http.StatusOK, `{"data":{
+		"set": {"id": "swsh3", "name": "Darkness Ablaze", "cardCount": {"official": 189}, "cards": [{"id": "swsh3-1"}]},
+		"series": [{"id": "swsh", "name": "Sword & Shield", "sets": [{"id": "swsh1"}]}]
+	}}`)
+
+	c := NewClient(WithEndpoint(srv.URL), WithHTTPClient(srv.Client()))
+
+	set, err := c.Set(context.Background(), "swsh3")
+	assert.NoError(t, err)
+	assert.Equal(t, 189, set.CardCount.Official)
+	assert.Equal(t, "swsh3-1", set.Cards[0].ID)
+
+	series, err := c.Series(context.Background(), SerieFilters{Name: "Sword"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "swsh1", series[0].Sets[0].ID)
+	assert.Equal(t, map[string]any{"filters": map[string]any{"name": "Sword"}}, recorded.payload.Variables)
+}
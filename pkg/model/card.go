@@ -1,5 +1,7 @@
 package model
 
+import "encoding/json"
+
 type Card struct {
 	Illustrator    string         `json:"illustrator"`
 	Category       string         `json:"category"`
@@ -63,11 +65,29 @@ type CardAbility struct {
 }
 
 type CardQueryOptions struct {
-	Id                     string `url:"id,omitempty"`
-	LocalId                string `url:"localId,omitempty"`
-	Name                   string `url:"name,omitempty"`
-	PaginationPage         int    `url:"pagination:page,omitempty"`
-	PaginationItemsPerPage int    `url:"pagination:itemsPerPage,omitempty"`
+	Id                     string  `url:"id,omitempty"`
+	LocalId                string  `url:"localId,omitempty"`
+	Name                   string  `url:"name,omitempty"`
+	Hp                     int     `url:"hp,omitempty"`
+	Types                  string  `url:"types,omitempty"`
+	Rarity                 string  `url:"rarity,omitempty"`
+	Illustrator            string  `url:"illustrator,omitempty"`
+	Category               string  `url:"category,omitempty"`
+	Stage                  string  `url:"stage,omitempty"`
+	EvolveFrom             string  `url:"evolveFrom,omitempty"`
+	Suffix                 string  `url:"suffix,omitempty"`
+	RegulationMark         string  `url:"regulationMark,omitempty"`
+	Retreat                *int    `url:"retreat,omitempty"`
+	DexId                  int     `url:"dexId,omitempty"`
+	TrainerType            string  `url:"trainerType,omitempty"`
+	EnergyType             string  `url:"energyType,omitempty"`
+	SetId                  string  `url:"set.id,omitempty"`
+	LegalStandard          *bool   `url:"legal.standard,omitempty"`
+	LegalExpanded          *bool   `url:"legal.expanded,omitempty"`
+	Filters                Filters `url:"filters,omitempty"`
+	Sort                   Sort    `url:"sort,omitempty"`
+	PaginationPage         int     `url:"pagination:page,omitempty"`
+	PaginationItemsPerPage int     `url:"pagination:itemsPerPage,omitempty"`
 }
 
 type CardBrief struct {
@@ -76,3 +96,39 @@ type CardBrief struct {
 	Name    string `json:"name"`
 	Image   string `json:"image"`
 }
+
+// CardGroup is one value of a card list, such as a type or an illustrator,
+// with the cards having it.
+type CardGroup struct {
+	Name  string      `json:"name"`
+	Cards []CardBrief `json:"cards"`
+}
+
+// UnmarshalJSON accepts a numeric name, as returned for hp, retreat and dex
+// id values.
+func (g *CardGroup) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name  json.RawMessage `json:"name"`
+		Cards []CardBrief     `json:"cards"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	g.Cards = raw.Cards
+	g.Name = ""
+	if len(raw.Name) == 0 || string(raw.Name) == "null" {
+		return nil
+	}
+	if raw.Name[0] == '"' {
+		return json.Unmarshal(raw.Name, &g.Name)
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw.Name, &number); err != nil {
+		return err
+	}
+	g.Name = number.String()
+
+	return nil
+}
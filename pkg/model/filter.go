@@ -0,0 +1,47 @@
+package model
+
+import "net/url"
+
+type FilterOperator string
+
+const (
+	FilterEq      FilterOperator = "eq"
+	FilterNeq     FilterOperator = "neq"
+	FilterGt      FilterOperator = "gt"
+	FilterGte     FilterOperator = "gte"
+	FilterLt      FilterOperator = "lt"
+	FilterLte     FilterOperator = "lte"
+	FilterLike    FilterOperator = "like"
+	FilterNot     FilterOperator = "not"
+	FilterNull    FilterOperator = "null"
+	FilterNotNull FilterOperator = "notnull"
+)
+
+// Filter is a TCGdex filter expression such as "gte:150" or "like:pika*".
+// A Filter without operator sends its value as is.
+type Filter struct {
+	Operator FilterOperator
+	Value    string
+}
+
+func (f Filter) String() string {
+	switch f.Operator {
+	case "":
+		return f.Value
+	case FilterNull, FilterNotNull:
+		return string(f.Operator) + ":"
+	}
+
+	return string(f.Operator) + ":" + f.Value
+}
+
+// Filters maps a card, set or serie field (e.g. "hp", "set.id") to a filter.
+type Filters map[string]Filter
+
+func (fs Filters) EncodeValues(_ string, values *url.Values) error {
+	for field, filter := range fs {
+		values.Add(field, filter.String())
+	}
+
+	return nil
+}
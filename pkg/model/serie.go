@@ -8,10 +8,12 @@ type Serie struct {
 }
 
 type SerieQueryOptions struct {
-	Id                     string `url:"id,omitempty"`
-	Name                   string `url:"name,omitempty"`
-	PaginationPage         int    `url:"pagination:page,omitempty"`
-	PaginationItemsPerPage int    `url:"pagination:itemsPerPage,omitempty"`
+	Id                     string  `url:"id,omitempty"`
+	Name                   string  `url:"name,omitempty"`
+	Filters                Filters `url:"filters,omitempty"`
+	Sort                   Sort    `url:"sort,omitempty"`
+	PaginationPage         int     `url:"pagination:page,omitempty"`
+	PaginationItemsPerPage int     `url:"pagination:itemsPerPage,omitempty"`
 }
 
 type SerieBrief struct {
@@ -33,10 +33,12 @@ type Abbreviation struct {
 }
 
 type SetQueryOptions struct {
-	Id                     string `url:"id,omitempty"`
-	Name                   string `url:"name,omitempty"`
-	PaginationPage         int    `url:"pagination:page,omitempty"`
-	PaginationItemsPerPage int    `url:"pagination:itemsPerPage,omitempty"`
+	Id                     string  `url:"id,omitempty"`
+	Name                   string  `url:"name,omitempty"`
+	Filters                Filters `url:"filters,omitempty"`
+	Sort                   Sort    `url:"sort,omitempty"`
+	PaginationPage         int     `url:"pagination:page,omitempty"`
+	PaginationItemsPerPage int     `url:"pagination:itemsPerPage,omitempty"`
 }
 
 type SetBrief struct {
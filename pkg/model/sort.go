@@ -0,0 +1,33 @@
+package model
+
+import "net/url"
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "ASC"
+	SortDesc SortOrder = "DESC"
+)
+
+type SortKey struct {
+	Field string
+	Order SortOrder
+}
+
+// Sort lists the sort keys by priority. Each key is sent as a
+// sort:field/sort:order pair, an empty order being sent as ASC.
+type Sort []SortKey
+
+func (s Sort) EncodeValues(_ string, values *url.Values) error {
+	for _, key := range s {
+		order := key.Order
+		if order == "" {
+			order = SortAsc
+		}
+
+		values.Add("sort:field", key.Field)
+		values.Add("sort:order", string(order))
+	}
+
+	return nil
+}
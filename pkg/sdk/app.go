@@ -2,20 +2,18 @@ package sdk
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
 )
 
+const maxErrorBodySize = 64 << 10
+
 func decodeJSONResponse[T any](resp *http.Response, target *T) error {
 	if resp.StatusCode != http.StatusOK {
-		var httpErr model.TcgdexHttpError
-		if err := json.NewDecoder(resp.Body).Decode(&httpErr); err != nil {
-			return fmt.Errorf("decode error response: %w", err)
-		}
-		return errors.New(httpErr.String())
+		return newAPIError(resp)
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
@@ -24,3 +22,38 @@ func decodeJSONResponse[T any](resp *http.Response, target *T) error {
 
 	return nil
 }
+
+// newAPIError builds an APIError from a failed response. Bodies that are not
+// a TCGdex JSON error (e.g. an HTML 502 page from a proxy) are kept raw and
+// the remaining fields are filled from the response itself.
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	apiErr := &APIError{
+		Status: resp.StatusCode,
+		Title:  http.StatusText(resp.StatusCode),
+		Body:   body,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Endpoint = resp.Request.URL.Path
+	}
+
+	var httpErr model.TcgdexHttpError
+	if err := json.Unmarshal(body, &httpErr); err != nil {
+		return apiErr
+	}
+
+	apiErr.Type = httpErr.Type
+	if httpErr.Title != "" {
+		apiErr.Title = httpErr.Title
+	}
+	if httpErr.Endpoint != "" {
+		apiErr.Endpoint = httpErr.Endpoint
+	}
+	if httpErr.Method != "" {
+		apiErr.Method = httpErr.Method
+	}
+
+	return apiErr
+}
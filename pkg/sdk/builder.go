@@ -0,0 +1,364 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// queryParams holds the state shared by the query builders. Every field is
+// kept as a filter, a plain value being a filter without operator.
+type queryParams struct {
+	filters      model.Filters
+	sort         model.Sort
+	page         int
+	itemsPerPage int
+}
+
+// where sets field to value, which is either a model.Filter or a plain value.
+func (p *queryParams) where(field string, value any) {
+	if p.filters == nil {
+		p.filters = make(model.Filters)
+	}
+
+	switch v := value.(type) {
+	case model.Filter:
+		p.filters[field] = v
+	default:
+		p.filters[field] = model.Filter{Value: fmt.Sprint(v)}
+	}
+}
+
+func (p *queryParams) sortBy(field string, order model.SortOrder) {
+	p.sort = append(p.sort, model.SortKey{Field: field, Order: order})
+}
+
+func (p *queryParams) clone() queryParams {
+	return queryParams{
+		filters:      maps.Clone(p.filters),
+		sort:         slices.Clone(p.sort),
+		page:         p.page,
+		itemsPerPage: p.itemsPerPage,
+	}
+}
+
+func encodeQuery(options any) string {
+	values, err := query.Values(options)
+	if err != nil {
+		return ""
+	}
+
+	return values.Encode()
+}
+
+// parseQueryParams parses a query string as produced by the Encode method of
+// the builders.
+func parseQueryParams(s string) (queryParams, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return queryParams{}, fmt.Errorf("parse query: %w", err)
+	}
+
+	var p queryParams
+	for key, vals := range values {
+		switch key {
+		case "pagination:page":
+			if p.page, err = strconv.Atoi(vals[0]); err != nil {
+				return queryParams{}, fmt.Errorf("parse page: %w", err)
+			}
+		case "pagination:itemsPerPage":
+			if p.itemsPerPage, err = strconv.Atoi(vals[0]); err != nil {
+				return queryParams{}, fmt.Errorf("parse items per page: %w", err)
+			}
+		case "sort:field", "sort:order":
+		default:
+			if len(vals) > 1 {
+				return queryParams{}, fmt.Errorf("parse query: several values for %q", key)
+			}
+			p.where(key, parseFilter(vals[0]))
+		}
+	}
+
+	fields, orders := values["sort:field"], values["sort:order"]
+	for i, field := range fields {
+		key := model.SortKey{Field: field}
+		if i < len(orders) {
+			key.Order = model.SortOrder(orders[i])
+		}
+		p.sort = append(p.sort, key)
+	}
+
+	return p, nil
+}
+
+func parseFilter(s string) model.Filter {
+	operator, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return model.Filter{Value: s}
+	}
+
+	switch op := model.FilterOperator(operator); op {
+	case model.FilterEq, model.FilterNeq, model.FilterGt, model.FilterGte,
+		model.FilterLt, model.FilterLte, model.FilterLike, model.FilterNot:
+		return model.Filter{Operator: op, Value: value}
+	case model.FilterNull, model.FilterNotNull:
+		return model.Filter{Operator: op}
+	}
+
+	return model.Filter{Value: s}
+}
+
+// CardQuery builds card searches fluently:
+//
+//	sdk.Cards().Name(sdk.Like("char*")).HP(sdk.Gte(100)).Types("Fire").SortBy("localId", sdk.Asc).Page(2, 50)
+//
+// Field methods accept either a model.Filter or a plain value.
+type CardQuery struct {
+	params queryParams
+}
+
+func Cards() *CardQuery {
+	return &CardQuery{}
+}
+
+// ParseCardQuery parses a query string produced by CardQuery.Encode.
+func ParseCardQuery(s string) (*CardQuery, error) {
+	params, err := parseQueryParams(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CardQuery{params: params}, nil
+}
+
+func (q *CardQuery) Where(field string, value any) *CardQuery {
+	q.params.where(field, value)
+	return q
+}
+
+func (q *CardQuery) ID(value any) *CardQuery             { return q.Where("id", value) }
+func (q *CardQuery) LocalID(value any) *CardQuery        { return q.Where("localId", value) }
+func (q *CardQuery) Name(value any) *CardQuery           { return q.Where("name", value) }
+func (q *CardQuery) HP(value any) *CardQuery             { return q.Where("hp", value) }
+func (q *CardQuery) Types(value any) *CardQuery          { return q.Where("types", value) }
+func (q *CardQuery) Rarity(value any) *CardQuery         { return q.Where("rarity", value) }
+func (q *CardQuery) Illustrator(value any) *CardQuery    { return q.Where("illustrator", value) }
+func (q *CardQuery) Category(value any) *CardQuery       { return q.Where("category", value) }
+func (q *CardQuery) Stage(value any) *CardQuery          { return q.Where("stage", value) }
+func (q *CardQuery) EvolveFrom(value any) *CardQuery     { return q.Where("evolveFrom", value) }
+func (q *CardQuery) Suffix(value any) *CardQuery         { return q.Where("suffix", value) }
+func (q *CardQuery) RegulationMark(value any) *CardQuery { return q.Where("regulationMark", value) }
+func (q *CardQuery) Retreat(value any) *CardQuery        { return q.Where("retreat", value) }
+func (q *CardQuery) DexID(value any) *CardQuery          { return q.Where("dexId", value) }
+func (q *CardQuery) TrainerType(value any) *CardQuery    { return q.Where("trainerType", value) }
+func (q *CardQuery) EnergyType(value any) *CardQuery     { return q.Where("energyType", value) }
+func (q *CardQuery) SetID(value any) *CardQuery          { return q.Where("set.id", value) }
+func (q *CardQuery) LegalStandard(value any) *CardQuery  { return q.Where("legal.standard", value) }
+func (q *CardQuery) LegalExpanded(value any) *CardQuery  { return q.Where("legal.expanded", value) }
+
+func (q *CardQuery) SortBy(field string, order model.SortOrder) *CardQuery {
+	q.params.sortBy(field, order)
+	return q
+}
+
+func (q *CardQuery) Page(page, itemsPerPage int) *CardQuery {
+	q.params.page, q.params.itemsPerPage = page, itemsPerPage
+	return q
+}
+
+func (q *CardQuery) Build() model.CardQueryOptions {
+	params := q.params.clone()
+
+	return model.CardQueryOptions{
+		Filters:                params.filters,
+		Sort:                   params.sort,
+		PaginationPage:         params.page,
+		PaginationItemsPerPage: params.itemsPerPage,
+	}
+}
+
+func (q *CardQuery) Search(ctx context.Context, f Fetcheable) ([]model.CardBrief, error) {
+	return f.SearchCardsCtx(ctx, q.Build())
+}
+
+// All iterates every page starting at the query page.
+func (q *CardQuery) All(ctx context.Context, f Fetcheable) iter.Seq2[model.CardBrief, error] {
+	return f.AllCards(ctx, q.Build())
+}
+
+// Encode returns the query string of the search, suitable to save it.
+func (q *CardQuery) Encode() string {
+	return encodeQuery(q.Build())
+}
+
+func (q *CardQuery) MarshalText() ([]byte, error) {
+	return []byte(q.Encode()), nil
+}
+
+func (q *CardQuery) UnmarshalText(text []byte) error {
+	params, err := parseQueryParams(string(text))
+	if err != nil {
+		return err
+	}
+
+	q.params = params
+	return nil
+}
+
+// SetQuery builds set searches fluently, see CardQuery.
+type SetQuery struct {
+	params queryParams
+}
+
+func Sets() *SetQuery {
+	return &SetQuery{}
+}
+
+// ParseSetQuery parses a query string produced by SetQuery.Encode.
+func ParseSetQuery(s string) (*SetQuery, error) {
+	params, err := parseQueryParams(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetQuery{params: params}, nil
+}
+
+func (q *SetQuery) Where(field string, value any) *SetQuery {
+	q.params.where(field, value)
+	return q
+}
+
+func (q *SetQuery) ID(value any) *SetQuery          { return q.Where("id", value) }
+func (q *SetQuery) Name(value any) *SetQuery        { return q.Where("name", value) }
+func (q *SetQuery) ReleaseDate(value any) *SetQuery { return q.Where("releaseDate", value) }
+func (q *SetQuery) SerieID(value any) *SetQuery     { return q.Where("serie.id", value) }
+
+func (q *SetQuery) SortBy(field string, order model.SortOrder) *SetQuery {
+	q.params.sortBy(field, order)
+	return q
+}
+
+func (q *SetQuery) Page(page, itemsPerPage int) *SetQuery {
+	q.params.page, q.params.itemsPerPage = page, itemsPerPage
+	return q
+}
+
+func (q *SetQuery) Build() model.SetQueryOptions {
+	params := q.params.clone()
+
+	return model.SetQueryOptions{
+		Filters:                params.filters,
+		Sort:                   params.sort,
+		PaginationPage:         params.page,
+		PaginationItemsPerPage: params.itemsPerPage,
+	}
+}
+
+func (q *SetQuery) Search(ctx context.Context, f Fetcheable) ([]model.SetBrief, error) {
+	return f.SearchSetsCtx(ctx, q.Build())
+}
+
+func (q *SetQuery) All(ctx context.Context, f Fetcheable) iter.Seq2[model.SetBrief, error] {
+	return f.AllSets(ctx, q.Build())
+}
+
+func (q *SetQuery) Encode() string {
+	return encodeQuery(q.Build())
+}
+
+func (q *SetQuery) MarshalText() ([]byte, error) {
+	return []byte(q.Encode()), nil
+}
+
+func (q *SetQuery) UnmarshalText(text []byte) error {
+	params, err := parseQueryParams(string(text))
+	if err != nil {
+		return err
+	}
+
+	q.params = params
+	return nil
+}
+
+// SerieQuery builds serie searches fluently, see CardQuery.
+type SerieQuery struct {
+	params queryParams
+}
+
+func Series() *SerieQuery {
+	return &SerieQuery{}
+}
+
+// ParseSerieQuery parses a query string produced by SerieQuery.Encode.
+func ParseSerieQuery(s string) (*SerieQuery, error) {
+	params, err := parseQueryParams(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SerieQuery{params: params}, nil
+}
+
+func (q *SerieQuery) Where(field string, value any) *SerieQuery {
+	q.params.where(field, value)
+	return q
+}
+
+func (q *SerieQuery) ID(value any) *SerieQuery   { return q.Where("id", value) }
+func (q *SerieQuery) Name(value any) *SerieQuery { return q.Where("name", value) }
+
+func (q *SerieQuery) SortBy(field string, order model.SortOrder) *SerieQuery {
+	q.params.sortBy(field, order)
+	return q
+}
+
+func (q *SerieQuery) Page(page, itemsPerPage int) *SerieQuery {
+	q.params.page, q.params.itemsPerPage = page, itemsPerPage
+	return q
+}
+
+func (q *SerieQuery) Build() model.SerieQueryOptions {
+	params := q.params.clone()
+
+	return model.SerieQueryOptions{
+		Filters:                params.filters,
+		Sort:                   params.sort,
+		PaginationPage:         params.page,
+		PaginationItemsPerPage: params.itemsPerPage,
+	}
+}
+
+func (q *SerieQuery) Search(ctx context.Context, f Fetcheable) ([]model.SerieBrief, error) {
+	return f.SearchSeriesCtx(ctx, q.Build())
+}
+
+func (q *SerieQuery) All(ctx context.Context, f Fetcheable) iter.Seq2[model.SerieBrief, error] {
+	return f.AllSeries(ctx, q.Build())
+}
+
+func (q *SerieQuery) Encode() string {
+	return encodeQuery(q.Build())
+}
+
+func (q *SerieQuery) MarshalText() ([]byte, error) {
+	return []byte(q.Encode()), nil
+}
+
+func (q *SerieQuery) UnmarshalText(text []byte) error {
+	params, err := parseQueryParams(string(text))
+	if err != nil {
+		return err
+	}
+
+	q.params = params
+	return nil
+}
@@ -0,0 +1,122 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+func TestCardQueryBuild(t *testing.T) {
+	options := Cards().Name(Like("char*")).HP(Gte(100)).Types("Fire").SortBy("localId", Asc).Page(2, 50).Build()
+
+	assert.Equal(t, model.CardQueryOptions{
+		Filters: model.Filters{
+			"name":  Like("char*"),
+			"hp":    Gte(100),
+			"types": {Value: "Fire"},
+		},
+		Sort:                   model.Sort{{Field: "localId", Order: Asc}},
+		PaginationPage:         2,
+		PaginationItemsPerPage: 50,
+	}, options)
+}
+
+func TestCardQuerySearch(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := Cards().Name(Like("char*")).HP(Gte(100)).Types("Fire").SetID("swsh3").SortBy("localId", Asc).Page(2, 50).
+		Search(context.Background(), f)
+	assert.NoError(t, err)
+	assert.Equal(t, "hp=gte%3A100&name=like%3Achar%2A&pagination%3AitemsPerPage=50&pagination%3Apage=2&set.id=swsh3&sort%3Afield=localId&sort%3Aorder=ASC&types=Fire", rawQuery)
+}
+
+func TestSetAndSerieQuerySearch(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := Sets().SerieID("swsh").SortBy("releaseDate", Desc).Search(context.Background(), f)
+	assert.NoError(t, err)
+	assert.Equal(t, "serie.id=swsh&sort%3Afield=releaseDate&sort%3Aorder=DESC", rawQuery)
+
+	_, err = Series().Name(Like("sword*")).Search(context.Background(), f)
+	assert.NoError(t, err)
+	assert.Equal(t, "name=like%3Asword%2A", rawQuery)
+}
+
+func TestCardQueryInvalidSort(t *testing.T) {
+	f := New(WithBaseURL("http://127.0.0.1:0"))
+
+	_, err := Cards().SortBy("releaseDate", Asc).Search(context.Background(), f)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
+
+func TestCardQueryRoundTrip(t *testing.T) {
+	q := Cards().Name(Like("char*")).HP(Gte(100)).Types("Fire").Retreat(Lte(2)).Suffix(IsNull()).
+		SortBy("hp", Desc).SortBy("localId", Asc).Page(2, 50)
+
+	parsed, err := ParseCardQuery(q.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, q.Build(), parsed.Build())
+	assert.Equal(t, q.Encode(), parsed.Encode())
+}
+
+func TestQueryJSONRoundTrip(t *testing.T) {
+	type savedSearch struct {
+		Cards *CardQuery  `json:"cards"`
+		Sets  *SetQuery   `json:"sets"`
+		Serie *SerieQuery `json:"series"`
+	}
+
+	saved := savedSearch{
+		Cards: Cards().Illustrator("Mitsuhiro Arita").Page(1, 10),
+		Sets:  Sets().Name(Neq("Jungle")),
+		Serie: Series().ID("swsh"),
+	}
+
+	data, err := json.Marshal(saved)
+	assert.NoError(t, err)
+
+	var loaded savedSearch
+	assert.NoError(t, json.Unmarshal(data, &loaded))
+	assert.Equal(t, saved.Cards.Build(), loaded.Cards.Build())
+	assert.Equal(t, saved.Sets.Build(), loaded.Sets.Build())
+	assert.Equal(t, saved.Serie.Build(), loaded.Serie.Build())
+}
+
+func TestParseCardQuery(t *testing.T) {
+	q, err := ParseCardQuery("name=pikachu&hp=gte%3A60&regulationMark=notnull%3A&illustrator=a%3Ab&sort%3Afield=hp&pagination%3Apage=3")
+	assert.NoError(t, err)
+	assert.Equal(t, model.CardQueryOptions{
+		Filters: model.Filters{
+			"name":           {Value: "pikachu"},
+			"hp":             Gte("60"),
+			"regulationMark": NotNull(),
+			"illustrator":    {Value: "a:b"},
+		},
+		Sort:           model.Sort{{Field: "hp"}},
+		PaginationPage: 3,
+	}, q.Build())
+
+	_, err = ParseCardQuery("pagination%3Apage=two")
+	assert.Error(t, err)
+
+	_, err = ParseCardQuery("name=a&name=b")
+	assert.Error(t, err)
+}
+
+func TestQueryBuildIsolated(t *testing.T) {
+	q := Cards().Name("pikachu")
+	options := q.Build()
+	q.Name("raichu").SortBy("hp", Asc)
+
+	assert.Equal(t, model.Filter{Value: "pikachu"}, options.Filters["name"])
+	assert.Empty(t, options.Sort)
+}
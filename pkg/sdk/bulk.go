@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// DefaultConcurrency is the number of workers used by the bulk helpers when
+// none is given.
+const DefaultConcurrency = 8
+
+type BulkFetcher interface {
+	FetchCards(ctx context.Context, cardIDs []string, concurrency int) []CardResult
+}
+
+// CardResult is the outcome of fetching one card of a batch, exactly one of
+// Card and Err is set.
+type CardResult struct {
+	ID   string
+	Card *model.Card
+	Err  error
+}
+
+// FetchCards fetches cardIDs with at most concurrency requests in flight and
+// returns one result per ID, in the order of cardIDs. A failed card does not
+// stop the batch, while a canceled context fails the cards not fetched yet.
+// Every request goes through the client so the rate limiter, the cache and
+// the deduplication apply.
+func (f *fetcher) FetchCards(ctx context.Context, cardIDs []string, concurrency int) []CardResult {
+	return f.fetchCards(ctx, cardIDs, concurrency, nil)
+}
+
+// fetchCards calls done, when not nil, after each card from the workers.
+func (f *fetcher) fetchCards(ctx context.Context, cardIDs []string, concurrency int, done func(CardResult)) []CardResult {
+	results := make([]CardResult, len(cardIDs))
+	forEachConcurrent(len(cardIDs), concurrency, func(i int) {
+		result := CardResult{ID: cardIDs[i]}
+		if err := ctx.Err(); err != nil {
+			result.Err = fmt.Errorf("fetch card %s: %w", cardIDs[i], err)
+		} else {
+			result.Card, result.Err = f.FetchSingleCardCtx(ctx, cardIDs[i])
+		}
+
+		results[i] = result
+		if done != nil {
+			done(result)
+		}
+	})
+
+	return results
+}
+
+// forEachConcurrent calls fn for every index below n from at most
+// concurrency goroutines, DefaultConcurrency when not positive.
+func forEachConcurrent(n, concurrency int, fn func(i int)) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	concurrency = min(concurrency, n)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := range n {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/cache"
+)
+
+// newCardServer serves any card whose id does not end with "-404", tracking
+// the number of requests and the highest number of them in flight.
+func newCardServer(calls, inFlight, maxInFlight *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			peak := maxInFlight.Load()
+			if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		id := strings.TrimPrefix(r.URL.Path, "/en/cards/")
+		if strings.HasSuffix(id, "-404") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"id":%q}`, id)
+	}))
+}
+
+func TestFetchCards(t *testing.T) {
+	var calls, inFlight, maxInFlight atomic.Int32
+	srv := newCardServer(&calls, &inFlight, &maxInFlight)
+	defer srv.Close()
+
+	ids := make([]string, 20)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("swsh3-%d", i)
+	}
+	ids[7] = "swsh3-404"
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	results := f.FetchCards(context.Background(), ids, 4)
+
+	assert.Len(t, results, len(ids))
+	for i, result := range results {
+		assert.Equal(t, ids[i], result.ID)
+		if i == 7 {
+			assert.Nil(t, result.Card)
+			assert.ErrorIs(t, result.Err, ErrNotFound)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.Equal(t, ids[i], result.Card.ID)
+	}
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(4))
+	assert.EqualValues(t, len(ids), calls.Load())
+}
+
+func TestFetchCardsUsesCache(t *testing.T) {
+	var calls, inFlight, maxInFlight atomic.Int32
+	srv := newCardServer(&calls, &inFlight, &maxInFlight)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(cache.NewLRU(10)))
+	ids := []string{"swsh3-1", "swsh3-2", "swsh3-3"}
+
+	f.FetchCards(context.Background(), ids, 0)
+	results := f.FetchCards(context.Background(), ids, 0)
+
+	assert.EqualValues(t, 3, calls.Load())
+	for i, result := range results {
+		assert.NoError(t, result.Err)
+		assert.Equal(t, ids[i], result.Card.ID)
+	}
+}
+
+func TestFetchCardsCanceled(t *testing.T) {
+	var calls, inFlight, maxInFlight atomic.Int32
+	srv := newCardServer(&calls, &inFlight, &maxInFlight)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	results := f.FetchCards(ctx, []string{"swsh3-1", "swsh3-2"}, 2)
+
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+	assert.Zero(t, calls.Load())
+}
+
+func TestFetchCardsEmpty(t *testing.T) {
+	f := New(WithBaseURL("http://127.0.0.1:0"))
+	assert.Empty(t, f.FetchCards(context.Background(), nil, 4))
+}
@@ -0,0 +1,246 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultCacheTTL      = time.Hour
+	DefaultCacheStaleTTL = 24 * time.Hour
+)
+
+// Cache stores successful responses keyed by normalized request URL.
+// Implementations must be safe for concurrent use, see package cache.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
+}
+
+// Endpoint is the top level resource of a request, used to configure
+// per-endpoint cache TTLs.
+type Endpoint string
+
+const (
+	EndpointCards           Endpoint = "cards"
+	EndpointSets            Endpoint = "sets"
+	EndpointSeries          Endpoint = "series"
+	EndpointTypes           Endpoint = "types"
+	EndpointRetreats        Endpoint = "retreats"
+	EndpointRarities        Endpoint = "rarities"
+	EndpointIllustrators    Endpoint = "illustrators"
+	EndpointCategories      Endpoint = "categories"
+	EndpointStages          Endpoint = "stages"
+	EndpointSuffixes        Endpoint = "suffixes"
+	EndpointVariants        Endpoint = "variants"
+	EndpointHP              Endpoint = "hp"
+	EndpointEnergyTypes     Endpoint = "energy-types"
+	EndpointTrainerTypes    Endpoint = "trainer-types"
+	EndpointRegulationMarks Endpoint = "regulation-marks"
+	EndpointDexIds          Endpoint = "dex-ids"
+	// EndpointRandom responses are neither cached, unless a TTL is set with
+	// WithEndpointCacheTTL, nor shared between concurrent callers.
+	EndpointRandom Endpoint = "random"
+)
+
+type CacheMode int
+
+const (
+	// CacheDefault serves fresh cached responses and stores new ones.
+	CacheDefault CacheMode = iota
+	// CacheBypass neither reads nor writes the cache.
+	CacheBypass
+	// CacheRefresh skips the cached response and stores the new one.
+	CacheRefresh
+)
+
+type cacheModeContextKey struct{}
+
+// ContextWithCacheMode sets the cache mode of the requests made with the
+// returned context.
+func ContextWithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeContextKey{}, mode)
+}
+
+func cacheModeFromContext(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeContextKey{}).(CacheMode)
+	return mode
+}
+
+// WithCache caches successful responses for DefaultCacheTTL, unless changed
+// with WithCacheTTL or WithEndpointCacheTTL.
+func WithCache(cache Cache) Option {
+	return func(c *config) {
+		c.cache = cache
+	}
+}
+
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *config) {
+		c.cacheTTL = ttl
+	}
+}
+
+// WithCacheStaleTTL sets how long an expired response carrying an ETag or a
+// Last-Modified header is kept to revalidate it with a conditional request.
+func WithCacheStaleTTL(ttl time.Duration) Option {
+	return func(c *config) {
+		c.cacheStaleTTL = ttl
+	}
+}
+
+// WithEndpointCacheTTL overrides the TTL of one endpoint. A non positive TTL
+// disables caching for it.
+func WithEndpointCacheTTL(endpoint Endpoint, ttl time.Duration) Option {
+	return func(c *config) {
+		c.endpointCacheTTL[endpoint] = ttl
+	}
+}
+
+func (f *fetcher) cacheTTL(endpoint Endpoint) time.Duration {
+	if ttl, ok := f.endpointCacheTTL[endpoint]; ok {
+		return ttl
+	}
+
+	return f.defaultCacheTTL
+}
+
+// cacheEntry is the value stored in the Cache for a response.
+type cacheEntry struct {
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	FreshUntil   time.Time `json:"freshUntil"`
+}
+
+func (e *cacheEntry) revalidatable() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// doCached serves req from the cache when possible and stores successful
+// responses. Expired entries with validators are revalidated with a
+// conditional request, a 304 answer being served from the cache.
+func (f *fetcher) doCached(req *http.Request, endpoint Endpoint) (*http.Response, error) {
+	ctx := req.Context()
+
+	ttl := f.cacheTTL(endpoint)
+	mode := cacheModeFromContext(ctx)
+	if ttl <= 0 || mode == CacheBypass {
+		return f.doRetry(req)
+	}
+
+	key := req.URL.String()
+
+	var entry *cacheEntry
+	if mode != CacheRefresh {
+		entry = f.loadCacheEntry(key)
+	}
+
+	switch {
+	case entry == nil:
+	case time.Now().Before(entry.FreshUntil):
+		f.logger.DebugContext(ctx, "tcgdex cache hit", "url", key)
+		return cachedResponse(req, entry.Body), nil
+	case entry.revalidatable():
+		req = req.Clone(ctx)
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	default:
+		entry = nil
+	}
+
+	resp, err := f.doRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		f.logger.DebugContext(ctx, "tcgdex cache revalidated", "url", key)
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+			entry.LastModified = lastModified
+		}
+		f.storeCacheEntry(key, entry, ttl)
+
+		return cachedResponse(req, entry.Body), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	f.storeCacheEntry(key, &cacheEntry{
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, ttl)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+func (f *fetcher) loadCacheEntry(key string) *cacheEntry {
+	value, ok := f.cache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(value, &entry); err != nil {
+		f.cache.Delete(key)
+		return nil
+	}
+
+	return &entry
+}
+
+// storeCacheEntry stores entry as fresh for ttl. Entries that can be
+// revalidated are kept for the stale TTL on top of it.
+func (f *fetcher) storeCacheEntry(key string, entry *cacheEntry, ttl time.Duration) {
+	entry.FreshUntil = time.Now().Add(ttl)
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	if entry.revalidatable() {
+		ttl += f.cacheStaleTTL
+	}
+	f.cache.Set(key, value, ttl)
+}
+
+func cachedResponse(req *http.Request, body []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json; charset=utf-8"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,158 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/cache"
+)
+
+var (
+	_ Cache = (*cache.LRU)(nil)
+	_ Cache = (*cache.Disk)(nil)
+)
+
+func newCountingServer(calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Path {
+		case "/en/types":
+			_, _ = w.Write([]byte(`["Fire","Water"]`))
+		case "/en/cards/swsh3-136":
+			_, _ = w.Write([]byte(`{"id":"swsh3-136"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCacheServesRepeatedCalls(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(cache.NewLRU(10)))
+	for range 3 {
+		cardTypes, err := f.ListCardTypes()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Fire", "Water"}, cardTypes)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCacheSkipsErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(cache.NewLRU(10)))
+	_, err := f.FetchSingleCard("notfound")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = f.FetchSingleCard("notfound")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCacheModes(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	c := cache.NewLRU(10)
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(c))
+
+	_, err := f.ListCardTypesCtx(ContextWithCacheMode(context.Background(), CacheBypass))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c.Len())
+
+	_, err = f.ListCardTypes()
+	assert.NoError(t, err)
+	_, err = f.ListCardTypesCtx(ContextWithCacheMode(context.Background(), CacheRefresh))
+	assert.NoError(t, err)
+	_, err = f.ListCardTypes()
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCacheEndpointTTL(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithCache(cache.NewLRU(10)),
+		WithCacheTTL(time.Hour),
+		WithEndpointCacheTTL(EndpointCards, 0),
+	)
+	for range 2 {
+		_, err := f.FetchSingleCard("swsh3-136")
+		assert.NoError(t, err)
+		_, err = f.ListCardTypes()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestCacheConditionalRequest(t *testing.T) {
+	var full, notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `W/"swsh1"` && r.Header.Get("If-Modified-Since") == "Mon, 10 Feb 2025 15:00:00 GMT" {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `W/"swsh1"`)
+		w.Header().Set("Last-Modified", "Mon, 10 Feb 2025 15:00:00 GMT")
+		_, _ = w.Write([]byte(`{"id":"swsh1","name":"Sword & Shield"}`))
+	}))
+	defer srv.Close()
+
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithCache(cache.NewLRU(10)),
+		WithCacheTTL(time.Nanosecond),
+	)
+	for range 3 {
+		set, err := f.GetSets("swsh1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Sword & Shield", set.Name)
+	}
+	assert.Equal(t, int32(1), full.Load())
+	assert.Equal(t, int32(2), notModified.Load())
+}
+
+func TestCacheConditionalRequestChanged(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, n))
+		_, _ = fmt.Fprintf(w, `{"id":"swsh1","name":"v%d"}`, n)
+	}))
+	defer srv.Close()
+
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithCache(cache.NewLRU(10)),
+		WithCacheTTL(time.Nanosecond),
+	)
+	set, err := f.GetSets("swsh1")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", set.Name)
+
+	set, err = f.GetSets("swsh1")
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", set.Name)
+}
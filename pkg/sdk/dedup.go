@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// flightGroup coalesces concurrent identical requests into a single one whose
+// response is shared by every caller.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done    chan struct{}
+	waiters int
+	cancel  context.CancelFunc
+
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{
+		flights: make(map[string]*flight),
+	}
+}
+
+// WithDeduplication enables or disables the coalescing of concurrent
+// identical requests. It is enabled by default.
+func WithDeduplication(enabled bool) Option {
+	return func(c *config) {
+		c.deduplication = enabled
+	}
+}
+
+// do runs fn once for all concurrent callers of the same request. The shared
+// request is detached from the callers' contexts and only canceled once every
+// caller gave up waiting, so one canceled caller does not fail the others.
+func (g *flightGroup) do(req *http.Request, fn func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := flightKey(req)
+
+	g.mu.Lock()
+	fl, ok := g.flights[key]
+	if !ok {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		fl = &flight{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		g.flights[key] = fl
+
+		go g.run(key, fl, req.Clone(flightCtx), fn)
+	}
+	fl.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-fl.done:
+		return fl.response(req)
+	case <-ctx.Done():
+		g.mu.Lock()
+		fl.waiters--
+		if fl.waiters == 0 {
+			fl.cancel()
+			if g.flights[key] == fl {
+				delete(g.flights, key)
+			}
+		}
+		g.mu.Unlock()
+
+		return nil, ctx.Err()
+	}
+}
+
+// flightKey identifies the requests that can share a response. The cache mode
+// is part of it since the flight runs with the context values of its first
+// caller, so a CacheRefresh caller never gets a cached response. The headers,
+// as left by the BeforeRequest hooks, are part of it too so that callers with
+// different credentials or request IDs never share a request.
+func flightKey(req *http.Request) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s %d", req.Method, req.URL.String(), cacheModeFromContext(req.Context()))
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\n%s: %q", name, req.Header[name])
+	}
+
+	return b.String()
+}
+
+func (g *flightGroup) run(key string, fl *flight, req *http.Request, fn func(*http.Request) (*http.Response, error)) {
+	defer close(fl.done)
+	defer fl.cancel()
+
+	resp, err := fn(req)
+	if err == nil {
+		fl.body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			err = fmt.Errorf("read response body: %w", err)
+		}
+	}
+	fl.resp, fl.err = resp, err
+
+	g.mu.Lock()
+	if g.flights[key] == fl {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+}
+
+// response returns a copy of the shared response with its own body.
+func (fl *flight) response(req *http.Request) (*http.Response, error) {
+	if fl.err != nil {
+		return nil, fl.err
+	}
+
+	resp := *fl.resp
+	resp.Header = fl.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(fl.body))
+	resp.Request = req
+
+	return &resp, nil
+}
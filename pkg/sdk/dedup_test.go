@@ -0,0 +1,220 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/cache"
+)
+
+// waitForWaiters waits until n callers wait for the flight of a GET request
+// to url made with ctx.
+func waitForWaiters(t *testing.T, f *fetcher, ctx context.Context, url string, n int) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	key := flightKey(req)
+
+	assert.Eventually(t, func() bool {
+		f.flights.mu.Lock()
+		defer f.flights.mu.Unlock()
+		fl, ok := f.flights.flights[key]
+		return ok && fl.waiters == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestDeduplicateConcurrentCalls(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"id":"swsh3-136"}`))
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL)).(*fetcher)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			card, err := f.FetchSingleCard("swsh3-136")
+			assert.NoError(t, err)
+			assert.Equal(t, "swsh3-136", card.ID)
+		}()
+	}
+	waitForWaiters(t, f, context.Background(), srv.URL+"/en/cards/swsh3-136", 10)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDeduplicateCanceledCaller(t *testing.T) {
+	release := make(chan struct{})
+	canceled := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+			_, _ = w.Write([]byte(`["Fire"]`))
+		case <-r.Context().Done():
+			close(canceled)
+		}
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL)).(*fetcher)
+	url := srv.URL + "/en/types"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := f.ListCardTypesCtx(ctx)
+		errs <- err
+	}()
+	waitForWaiters(t, f, ctx, url, 1)
+
+	result := make(chan []string, 1)
+	go func() {
+		cardTypes, err := f.ListCardTypes()
+		assert.NoError(t, err)
+		result <- cardTypes
+	}()
+	waitForWaiters(t, f, context.Background(), url, 2)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	close(release)
+	assert.Equal(t, []string{"Fire"}, <-result)
+
+	select {
+	case <-canceled:
+		t.Fatal("shared request canceled")
+	default:
+	}
+}
+
+func TestDeduplicateAllCallersCanceled(t *testing.T) {
+	arrived := make(chan struct{})
+	canceled := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(arrived)
+		<-r.Context().Done()
+		close(canceled)
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL)).(*fetcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := f.ListCardTypesCtx(ctx)
+		errs <- err
+	}()
+
+	// cancel once the shared request reached the server, before that the
+	// handler would never run
+	select {
+	case <-arrived:
+	case <-time.After(time.Second):
+		t.Fatal("shared request not sent")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("shared request not canceled")
+	}
+}
+
+func TestDeduplicateKeepsCacheModes(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		<-release
+		_, _ = fmt.Fprintf(w, `["call %d"]`, n)
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(cache.NewLRU(10))).(*fetcher)
+	url := srv.URL + "/en/types"
+	refresh := ContextWithCacheMode(context.Background(), CacheRefresh)
+
+	var wg sync.WaitGroup
+	results := make([][]string, 2)
+	for i, ctx := range []context.Context{context.Background(), refresh} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cardTypes, err := f.ListCardTypesCtx(ctx)
+			assert.NoError(t, err)
+			results[i] = cardTypes
+		}()
+		waitForWaiters(t, f, ctx, url, 1)
+	}
+	close(release)
+	wg.Wait()
+
+	// the refresh caller got its own request instead of joining the first one
+	assert.Equal(t, int32(2), calls.Load())
+	assert.NotEqual(t, results[0], results[1])
+}
+
+func TestDeduplicateKeepsHookHeaders(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		_, _ = fmt.Fprintf(w, `[%q]`, r.Header.Get("X-Request-Id"))
+	}))
+	defer srv.Close()
+
+	var ids atomic.Int32
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithHooks(Hooks{
+		BeforeRequest: func(endpoint Endpoint, req *http.Request) error {
+			req.Header.Set("X-Request-Id", fmt.Sprint(ids.Add(1)))
+			return nil
+		},
+	}))
+
+	var wg sync.WaitGroup
+	results := make([][]string, 2)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cardTypes, err := f.ListCardTypes()
+			assert.NoError(t, err)
+			results[i] = cardTypes
+		}()
+	}
+
+	// both requests reach the server while the first one is still in flight
+	assert.Eventually(t, func() bool {
+		return calls.Load() == 2
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.NotEqual(t, results[0], results[1])
+}
+
+func TestWithDeduplicationDisabled(t *testing.T) {
+	f := New(WithDeduplication(false)).(*fetcher)
+	assert.Nil(t, f.flights)
+}
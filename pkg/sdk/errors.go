@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrNotFound    = errors.New("tcgdex: not found")
+	ErrRateLimited = errors.New("tcgdex: rate limited")
+	ErrServer      = errors.New("tcgdex: server error")
+
+	ErrInvalidSort = errors.New("tcgdex: invalid sort")
+	ErrInvalidID   = errors.New("tcgdex: invalid id")
+
+	ErrConflictingFilter = errors.New("tcgdex: conflicting filter")
+)
+
+// APIError is returned when the TCGdex API answers with a non 200 status.
+// It matches ErrNotFound, ErrRateLimited and ErrServer through errors.Is.
+type APIError struct {
+	Status   int
+	Type     string
+	Title    string
+	Endpoint string
+	Method   string
+	Body     []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("tcgdex: %d %s (%s %s)", e.Status, e.Title, e.Method, e.Endpoint)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrServer:
+		return e.Status >= http.StatusInternalServerError
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestAPIErrorNotFound(t *testing.T) {
+	r, err := recorder.New("fixtures/get_sets_not_found")
+	assert.NoError(t, err)
+	defer func() {
+		err := r.Stop()
+		assert.NoError(t, err)
+	}()
+
+	f := NewFetcher(r.GetDefaultClient(), 5*time.Second, "https://api.tcgdex.net/v2/en")
+	_, err = f.GetSets("notfound")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrServer)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	assert.Equal(t, "https://tcgdex.dev/errors/not-found", apiErr.Type)
+	assert.Equal(t, "/en/sets/notfound", apiErr.Endpoint)
+	assert.Equal(t, "GET", apiErr.Method)
+}
+
+func TestAPIErrorRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	f := NewFetcher(srv.Client(), 5*time.Second, srv.URL)
+	_, err := f.ListCardTypes()
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
+
+func TestAPIErrorNonJSONBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html>bad gateway</html>"))
+	}))
+	defer srv.Close()
+
+	f := NewFetcher(srv.Client(), 5*time.Second, srv.URL)
+	_, err := f.FetchSingleCard("swsh3-136")
+	assert.ErrorIs(t, err, ErrServer)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadGateway, apiErr.Status)
+	assert.Equal(t, "Bad Gateway", apiErr.Title)
+	assert.Equal(t, "/cards/swsh3-136", apiErr.Endpoint)
+	assert.Equal(t, "<html>bad gateway</html>", string(apiErr.Body))
+}
@@ -1,351 +1,501 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
-	"net/url"
 	"time"
 
-	"github.com/google/go-querystring/query"
 	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
 )
 
 type Lister interface {
 	ListCardTypes() ([]string, error)
+	ListCardTypesCtx(ctx context.Context) ([]string, error)
 	ListCardRetreatCosts() ([]int, error)
+	ListCardRetreatCostsCtx(ctx context.Context) ([]int, error)
 	ListCardRarities() ([]string, error)
+	ListCardRaritiesCtx(ctx context.Context) ([]string, error)
 	ListCardIllustrators() ([]string, error)
+	ListCardIllustratorsCtx(ctx context.Context) ([]string, error)
 	ListCardCategories() ([]string, error)
+	ListCardCategoriesCtx(ctx context.Context) ([]string, error)
 	ListPokemonStages() ([]string, error)
+	ListPokemonStagesCtx(ctx context.Context) ([]string, error)
 	ListSuffixes() ([]string, error)
+	ListSuffixesCtx(ctx context.Context) ([]string, error)
 	ListVariants() ([]string, error)
+	ListVariantsCtx(ctx context.Context) ([]string, error)
+	ListCardHP() ([]int, error)
+	ListCardHPCtx(ctx context.Context) ([]int, error)
+	ListEnergyTypes() ([]string, error)
+	ListEnergyTypesCtx(ctx context.Context) ([]string, error)
+	ListTrainerTypes() ([]string, error)
+	ListTrainerTypesCtx(ctx context.Context) ([]string, error)
+	ListRegulationMarks() ([]string, error)
+	ListRegulationMarksCtx(ctx context.Context) ([]string, error)
+	ListDexIds() ([]int, error)
+	ListDexIdsCtx(ctx context.Context) ([]int, error)
 }
 
 type Fetcheable interface {
 	FetchSingleCard(cardID string) (*model.Card, error)
+	FetchSingleCardCtx(ctx context.Context, cardID string) (*model.Card, error)
 	SearchCards(options model.CardQueryOptions) ([]model.CardBrief, error)
+	SearchCardsCtx(ctx context.Context, options model.CardQueryOptions) ([]model.CardBrief, error)
 	GetSets(setID string) (*model.Set, error)
+	GetSetsCtx(ctx context.Context, setID string) (*model.Set, error)
 	SearchSets(options model.SetQueryOptions) ([]model.SetBrief, error)
+	SearchSetsCtx(ctx context.Context, options model.SetQueryOptions) ([]model.SetBrief, error)
 	GetCardBySetAndLocalId(setID, localID string) (*model.Card, error)
+	GetCardBySetAndLocalIdCtx(ctx context.Context, setID, localID string) (*model.Card, error)
 	GetSingleSerie(serieID string) (*model.Serie, error)
+	GetSingleSerieCtx(ctx context.Context, serieID string) (*model.Serie, error)
 	SearchSeries(options model.SerieQueryOptions) ([]model.SerieBrief, error)
+	SearchSeriesCtx(ctx context.Context, options model.SerieQueryOptions) ([]model.SerieBrief, error)
 	Lister
+	Localizer
+	Paginator
+	BulkFetcher
+	Hydrator
+	Grouper
+	Randomizer
 }
 
 type fetcher struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL     string
+	language    Language
+	httpClient  *http.Client
+	userAgent   string
+	headers     http.Header
+	logger      *slog.Logger
+	retry       *RetryPolicy
+	rateLimiter RateLimiter
+	flights     *flightGroup
+	hooks       []Hooks
+
+	cache            Cache
+	defaultCacheTTL  time.Duration
+	cacheStaleTTL    time.Duration
+	endpointCacheTTL map[Endpoint]time.Duration
 }
 
-func NewFetcher(client *http.Client, httpClientTimeout time.Duration, baseURL string) Fetcheable {
-	if client == nil {
-		client = &http.Client{
-			Timeout: httpClientTimeout,
-		}
+// New creates a client for the TCGdex API. Without options it targets
+// DefaultBaseURL in DefaultLanguage with a DefaultTimeout HTTP client.
+func New(opts ...Option) Fetcheable {
+	cfg := newConfig(opts)
+
+	var flights *flightGroup
+	if cfg.deduplication {
+		flights = newFlightGroup()
 	}
 
 	return &fetcher{
-		baseURL:    baseURL,
-		httpClient: client,
+		baseURL:     cfg.baseURL,
+		language:    cfg.language,
+		httpClient:  cfg.httpClient,
+		userAgent:   cfg.userAgent,
+		headers:     cfg.headers,
+		logger:      cfg.logger,
+		retry:       cfg.retry,
+		rateLimiter: cfg.rateLimiter,
+		flights:     flights,
+		hooks:       cfg.hooks,
+
+		cache:            cfg.cache,
+		defaultCacheTTL:  cfg.cacheTTL,
+		cacheStaleTTL:    cfg.cacheStaleTTL,
+		endpointCacheTTL: cfg.endpointCacheTTL,
 	}
 }
 
-func (f *fetcher) FetchSingleCard(cardID string) (*model.Card, error) {
-	url, err := url.Parse(f.baseURL + "/cards/" + cardID)
-	if err != nil {
-		return nil, fmt.Errorf("parse fetch single card: %w", err)
+// NewFetcher is kept for compatibility, baseURL must already contain the
+// language (e.g. https://api.tcgdex.net/v2/en). A trailing known language is
+// used as the client language so it can still be overridden per request.
+func NewFetcher(client *http.Client, httpClientTimeout time.Duration, baseURL string) Fetcheable {
+	baseURL, lang := splitLanguage(baseURL)
+
+	opts := []Option{
+		WithHTTPClient(client),
+		WithBaseURL(baseURL),
+		WithLanguage(lang),
+	}
+	// a zero timeout keeps the timeout of the given client
+	if httpClientTimeout > 0 {
+		opts = append(opts, WithTimeout(httpClientTimeout))
+	}
+
+	return New(opts...)
+}
+
+// languageBaseURL returns the base URL for the language of ctx, falling back
+// to the client language.
+func (f *fetcher) languageBaseURL(ctx context.Context) string {
+	lang := f.language
+	if override, ok := languageFromContext(ctx); ok {
+		lang = override
+	}
+
+	if lang == "" {
+		return f.baseURL
+	}
+
+	return f.baseURL + "/" + string(lang)
+}
+
+func (f *fetcher) doEndpoint(req *http.Request, endpoint Endpoint) (*http.Response, error) {
+	if f.cache != nil {
+		return f.doCached(req, endpoint)
+	}
+
+	return f.doRetry(req)
+}
+
+// doRetry sends req, retrying idempotent requests according to the retry policy.
+func (f *fetcher) doRetry(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attempts := 1
+	if f.retry != nil && isIdempotent(req.Method) {
+		attempts = max(f.retry.MaxAttempts, 1)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	for attempt := 1; ; attempt++ {
+		resp, err := f.send(req.Clone(ctx))
+		if attempt >= attempts || ctx.Err() != nil || !f.retry.retryable(resp, err) {
+			return resp, err
+		}
+
+		wait := f.retry.backoff(attempt, resp)
+		event := RetryEvent{
+			Attempt: attempt,
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Err:     err,
+			Wait:    wait,
+		}
+		if resp != nil {
+			event.StatusCode = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if f.retry.OnRetry != nil {
+			f.retry.OnRetry(event)
+		}
+		f.logger.DebugContext(ctx, "tcgdex request retry", "method", req.Method, "url", event.URL, "attempt", attempt, "wait", wait)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f *fetcher) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if f.rateLimiter != nil {
+		if err := f.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter: %w", err)
+		}
+	}
+
+	start := time.Now()
+	resp, err := f.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("get single card: %w", err)
+		f.logger.DebugContext(ctx, "tcgdex request failed", "method", req.Method, "url", req.URL.String(), "error", err)
+		return nil, err
 	}
 
-	var card model.Card
-	if err := decodeJSONResponse(httpResp, &card); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
+	f.logger.DebugContext(ctx, "tcgdex request", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "duration", time.Since(start))
+
+	return resp, nil
+}
+
+func (f *fetcher) FetchSingleCard(cardID string) (*model.Card, error) {
+	return f.FetchSingleCardCtx(context.Background(), cardID)
+}
+
+func (f *fetcher) FetchSingleCardCtx(ctx context.Context, cardID string) (*model.Card, error) {
+	card, err := do[model.Card](ctx, f, newRequest(EndpointCards, cardID))
+	if err != nil {
+		return nil, fmt.Errorf("get single card: %w", err)
 	}
 
 	return &card, nil
 }
 
 func (f *fetcher) SearchCards(options model.CardQueryOptions) ([]model.CardBrief, error) {
-	queryStrings, err := query.Values(options)
-	if err != nil {
-		return nil, fmt.Errorf("values: %w", err)
+	return f.SearchCardsCtx(context.Background(), options)
+}
+
+func (f *fetcher) SearchCardsCtx(ctx context.Context, options model.CardQueryOptions) ([]model.CardBrief, error) {
+	if err := validateSort(EndpointCards, options.Sort); err != nil {
+		return nil, fmt.Errorf("validate sort: %w", err)
 	}
 
-	url, err := url.Parse(f.baseURL + "/cards?" + queryStrings.Encode())
+	req, err := newRequest(EndpointCards).withQuery(options)
 	if err != nil {
-		return nil, fmt.Errorf("parse search cards: %w", err)
+		return nil, fmt.Errorf("values: %w", err)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	cardBriefs, err := do[[]model.CardBrief](ctx, f, req)
 	if err != nil {
 		return nil, fmt.Errorf("search cards: %w", err)
 	}
 
-	var cardBriefs []model.CardBrief
-	if err := decodeJSONResponse(httpResp, &cardBriefs); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardBriefs, nil
 }
 
 func (f *fetcher) GetSets(setID string) (*model.Set, error) {
-	url, err := url.Parse(f.baseURL + "/sets/" + setID)
-	if err != nil {
-		return nil, fmt.Errorf("parse fetch get sets: %w", err)
-	}
+	return f.GetSetsCtx(context.Background(), setID)
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) GetSetsCtx(ctx context.Context, setID string) (*model.Set, error) {
+	set, err := do[model.Set](ctx, f, newRequest(EndpointSets, setID))
 	if err != nil {
 		return nil, fmt.Errorf("get sets: %w", err)
 	}
 
-	var set model.Set
-	if err := decodeJSONResponse(httpResp, &set); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return &set, nil
 }
 
 func (f *fetcher) SearchSets(options model.SetQueryOptions) ([]model.SetBrief, error) {
-	queryStrings, err := query.Values(options)
-	if err != nil {
-		return nil, fmt.Errorf("values: %w", err)
+	return f.SearchSetsCtx(context.Background(), options)
+}
+
+func (f *fetcher) SearchSetsCtx(ctx context.Context, options model.SetQueryOptions) ([]model.SetBrief, error) {
+	if err := validateSort(EndpointSets, options.Sort); err != nil {
+		return nil, fmt.Errorf("validate sort: %w", err)
 	}
 
-	url, err := url.Parse(f.baseURL + "/sets?" + queryStrings.Encode())
+	req, err := newRequest(EndpointSets).withQuery(options)
 	if err != nil {
-		return nil, fmt.Errorf("parse search sets: %w", err)
+		return nil, fmt.Errorf("values: %w", err)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	setBriefs, err := do[[]model.SetBrief](ctx, f, req)
 	if err != nil {
 		return nil, fmt.Errorf("search sets: %w", err)
 	}
 
-	var setBriefs []model.SetBrief
-	if err := decodeJSONResponse(httpResp, &setBriefs); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return setBriefs, nil
 }
 
 func (f *fetcher) GetCardBySetAndLocalId(setID, localID string) (*model.Card, error) {
-	url, err := url.Parse(f.baseURL + "/sets/" + setID + "/" + localID)
-	if err != nil {
-		return nil, fmt.Errorf("parse fetch get card by set and localId: %w", err)
-	}
+	return f.GetCardBySetAndLocalIdCtx(context.Background(), setID, localID)
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) GetCardBySetAndLocalIdCtx(ctx context.Context, setID, localID string) (*model.Card, error) {
+	card, err := do[model.Card](ctx, f, newRequest(EndpointSets, setID, localID))
 	if err != nil {
 		return nil, fmt.Errorf("get card by set and localId: %w", err)
 	}
 
-	var card model.Card
-	if err := decodeJSONResponse(httpResp, &card); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return &card, nil
 }
 
 func (f *fetcher) GetSingleSerie(serieID string) (*model.Serie, error) {
-	url, err := url.Parse(f.baseURL + "/series/" + serieID)
-	if err != nil {
-		return nil, fmt.Errorf("parse get single serie: %w", err)
-	}
+	return f.GetSingleSerieCtx(context.Background(), serieID)
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) GetSingleSerieCtx(ctx context.Context, serieID string) (*model.Serie, error) {
+	serie, err := do[model.Serie](ctx, f, newRequest(EndpointSeries, serieID))
 	if err != nil {
 		return nil, fmt.Errorf("get single serie: %w", err)
 	}
 
-	var serie model.Serie
-	if err := decodeJSONResponse(httpResp, &serie); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return &serie, nil
 }
 
 func (f *fetcher) SearchSeries(options model.SerieQueryOptions) ([]model.SerieBrief, error) {
-	queryStrings, err := query.Values(options)
-	if err != nil {
-		return nil, fmt.Errorf("values: %w", err)
+	return f.SearchSeriesCtx(context.Background(), options)
+}
+
+func (f *fetcher) SearchSeriesCtx(ctx context.Context, options model.SerieQueryOptions) ([]model.SerieBrief, error) {
+	if err := validateSort(EndpointSeries, options.Sort); err != nil {
+		return nil, fmt.Errorf("validate sort: %w", err)
 	}
 
-	url, err := url.Parse(f.baseURL + "/series?" + queryStrings.Encode())
+	req, err := newRequest(EndpointSeries).withQuery(options)
 	if err != nil {
-		return nil, fmt.Errorf("parse search series: %w", err)
+		return nil, fmt.Errorf("values: %w", err)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	serieBriefs, err := do[[]model.SerieBrief](ctx, f, req)
 	if err != nil {
 		return nil, fmt.Errorf("search series: %w", err)
 	}
 
-	var serieBriefs []model.SerieBrief
-	if err := decodeJSONResponse(httpResp, &serieBriefs); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return serieBriefs, nil
 }
 
 func (f *fetcher) ListCardTypes() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/types")
-	if err != nil {
-		return nil, fmt.Errorf("parse list card types: %w", err)
-	}
+	return f.ListCardTypesCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListCardTypesCtx(ctx context.Context) ([]string, error) {
+	cardTypes, err := do[[]string](ctx, f, newRequest(EndpointTypes))
 	if err != nil {
 		return nil, fmt.Errorf("list card types: %w", err)
 	}
 
-	var cardTypes []string
-	if err := decodeJSONResponse(httpResp, &cardTypes); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardTypes, nil
 }
 
 func (f *fetcher) ListCardRetreatCosts() ([]int, error) {
-	url, err := url.Parse(f.baseURL + "/retreats")
-	if err != nil {
-		return nil, fmt.Errorf("parse list card retreat costs: %w", err)
-	}
+	return f.ListCardRetreatCostsCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListCardRetreatCostsCtx(ctx context.Context) ([]int, error) {
+	cardRetreatCosts, err := do[[]int](ctx, f, newRequest(EndpointRetreats))
 	if err != nil {
 		return nil, fmt.Errorf("list card retreat costs: %w", err)
 	}
 
-	var cardRetreatCosts []int
-	if err := decodeJSONResponse(httpResp, &cardRetreatCosts); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardRetreatCosts, nil
 }
 
 func (f *fetcher) ListCardRarities() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/rarities")
-	if err != nil {
-		return nil, fmt.Errorf("parse list card rarities: %w", err)
-	}
+	return f.ListCardRaritiesCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListCardRaritiesCtx(ctx context.Context) ([]string, error) {
+	cardRarities, err := do[[]string](ctx, f, newRequest(EndpointRarities))
 	if err != nil {
 		return nil, fmt.Errorf("list card rarities: %w", err)
 	}
 
-	var cardRarities []string
-	if err := decodeJSONResponse(httpResp, &cardRarities); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardRarities, nil
 }
 
 func (f *fetcher) ListCardIllustrators() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/illustrators")
-	if err != nil {
-		return nil, fmt.Errorf("parse list card illustrators: %w", err)
-	}
+	return f.ListCardIllustratorsCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListCardIllustratorsCtx(ctx context.Context) ([]string, error) {
+	cardIllustrators, err := do[[]string](ctx, f, newRequest(EndpointIllustrators))
 	if err != nil {
 		return nil, fmt.Errorf("list card illustrators: %w", err)
 	}
 
-	var cardIllustrators []string
-	if err := decodeJSONResponse(httpResp, &cardIllustrators); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardIllustrators, nil
 }
 
 func (f *fetcher) ListCardCategories() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/categories")
-	if err != nil {
-		return nil, fmt.Errorf("parse list card categories: %w", err)
-	}
+	return f.ListCardCategoriesCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListCardCategoriesCtx(ctx context.Context) ([]string, error) {
+	cardCategories, err := do[[]string](ctx, f, newRequest(EndpointCategories))
 	if err != nil {
 		return nil, fmt.Errorf("list card categories: %w", err)
 	}
 
-	var cardCategories []string
-	if err := decodeJSONResponse(httpResp, &cardCategories); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return cardCategories, nil
 }
 
 func (f *fetcher) ListPokemonStages() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/stages")
-	if err != nil {
-		return nil, fmt.Errorf("parse list pokemon stages: %w", err)
-	}
+	return f.ListPokemonStagesCtx(context.Background())
+}
 
-	httpResp, err := f.httpClient.Get(url.String())
+func (f *fetcher) ListPokemonStagesCtx(ctx context.Context) ([]string, error) {
+	pokemonStages, err := do[[]string](ctx, f, newRequest(EndpointStages))
 	if err != nil {
 		return nil, fmt.Errorf("list pokemon stages: %w", err)
 	}
 
-	var pokemonStages []string
-	if err := decodeJSONResponse(httpResp, &pokemonStages); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
-	}
-
 	return pokemonStages, nil
 }
 
 func (f *fetcher) ListSuffixes() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/suffixes")
+	return f.ListSuffixesCtx(context.Background())
+}
+
+func (f *fetcher) ListSuffixesCtx(ctx context.Context) ([]string, error) {
+	suffixes, err := do[[]string](ctx, f, newRequest(EndpointSuffixes))
 	if err != nil {
-		return nil, fmt.Errorf("parse list pokemon suffixes: %w", err)
+		return nil, fmt.Errorf("list pokemon suffixes: %w", err)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	return suffixes, nil
+}
+
+func (f *fetcher) ListVariants() ([]string, error) {
+	return f.ListVariantsCtx(context.Background())
+}
+
+func (f *fetcher) ListVariantsCtx(ctx context.Context) ([]string, error) {
+	variants, err := do[[]string](ctx, f, newRequest(EndpointVariants))
 	if err != nil {
-		return nil, fmt.Errorf("list pokemon suffixes: %w", err)
+		return nil, fmt.Errorf("list variants: %w", err)
 	}
 
-	var suffixes []string
-	if err := decodeJSONResponse(httpResp, &suffixes); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
+	return variants, nil
+}
+
+func (f *fetcher) ListCardHP() ([]int, error) {
+	return f.ListCardHPCtx(context.Background())
+}
+
+func (f *fetcher) ListCardHPCtx(ctx context.Context) ([]int, error) {
+	hps, err := do[[]int](ctx, f, newRequest(EndpointHP))
+	if err != nil {
+		return nil, fmt.Errorf("list card hp: %w", err)
 	}
 
-	return suffixes, nil
+	return hps, nil
 }
 
-func (f *fetcher) ListVariants() ([]string, error) {
-	url, err := url.Parse(f.baseURL + "/variants")
+func (f *fetcher) ListEnergyTypes() ([]string, error) {
+	return f.ListEnergyTypesCtx(context.Background())
+}
+
+func (f *fetcher) ListEnergyTypesCtx(ctx context.Context) ([]string, error) {
+	energyTypes, err := do[[]string](ctx, f, newRequest(EndpointEnergyTypes))
 	if err != nil {
-		return nil, fmt.Errorf("parse list variants: %w", err)
+		return nil, fmt.Errorf("list energy types: %w", err)
 	}
 
-	httpResp, err := f.httpClient.Get(url.String())
+	return energyTypes, nil
+}
+
+func (f *fetcher) ListTrainerTypes() ([]string, error) {
+	return f.ListTrainerTypesCtx(context.Background())
+}
+
+func (f *fetcher) ListTrainerTypesCtx(ctx context.Context) ([]string, error) {
+	trainerTypes, err := do[[]string](ctx, f, newRequest(EndpointTrainerTypes))
 	if err != nil {
-		return nil, fmt.Errorf("list variants: %w", err)
+		return nil, fmt.Errorf("list trainer types: %w", err)
+	}
+
+	return trainerTypes, nil
+}
+
+func (f *fetcher) ListRegulationMarks() ([]string, error) {
+	return f.ListRegulationMarksCtx(context.Background())
+}
+
+func (f *fetcher) ListRegulationMarksCtx(ctx context.Context) ([]string, error) {
+	regulationMarks, err := do[[]string](ctx, f, newRequest(EndpointRegulationMarks))
+	if err != nil {
+		return nil, fmt.Errorf("list regulation marks: %w", err)
 	}
 
-	var variants []string
-	if err := decodeJSONResponse(httpResp, &variants); err != nil {
-		return nil, fmt.Errorf("decode json response: %w", err)
+	return regulationMarks, nil
+}
+
+func (f *fetcher) ListDexIds() ([]int, error) {
+	return f.ListDexIdsCtx(context.Background())
+}
+
+func (f *fetcher) ListDexIdsCtx(ctx context.Context) ([]int, error) {
+	dexIds, err := do[[]int](ctx, f, newRequest(EndpointDexIds))
+	if err != nil {
+		return nil, fmt.Errorf("list dex ids: %w", err)
 	}
 
-	return variants, nil
+	return dexIds, nil
 }
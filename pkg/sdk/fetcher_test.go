@@ -1,6 +1,9 @@
 package sdk
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -465,3 +468,130 @@ func TestListVariants(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, variants, 5)
 }
+
+// newListServer serves body on path and fails the test for any other path.
+func newListServer(t *testing.T, path, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestListCardHP(t *testing.T) {
+	srv := newListServer(t, "/en/hp", `[30,60,120]`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	hps, err := f.ListCardHP()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{30, 60, 120}, hps)
+}
+
+func TestListEnergyTypes(t *testing.T) {
+	srv := newListServer(t, "/en/energy-types", `["Normal","Special"]`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	energyTypes, err := f.ListEnergyTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Normal", "Special"}, energyTypes)
+}
+
+func TestListTrainerTypes(t *testing.T) {
+	srv := newListServer(t, "/en/trainer-types", `["Item","Supporter"]`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	trainerTypes, err := f.ListTrainerTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Item", "Supporter"}, trainerTypes)
+}
+
+func TestListRegulationMarks(t *testing.T) {
+	srv := newListServer(t, "/en/regulation-marks", `["D","E"]`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	regulationMarks, err := f.ListRegulationMarks()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"D", "E"}, regulationMarks)
+}
+
+func TestListDexIds(t *testing.T) {
+	srv := newListServer(t, "/en/dex-ids", `[1,25,150]`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	dexIds, err := f.ListDexIds()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 25, 150}, dexIds)
+}
+
+func TestFetchSingleCardCtxOK(t *testing.T) {
+	r, err := recorder.New("fixtures/fetch_single_card_status_ok")
+	assert.NoError(t, err)
+	defer func() {
+		err := r.Stop()
+		assert.NoError(t, err)
+	}()
+
+	f := NewFetcher(r.GetDefaultClient(), 5*time.Second, "https://api.tcgdex.net/v2/en")
+	card, err := f.FetchSingleCardCtx(context.Background(), "swsh3-136")
+	assert.NoError(t, err)
+	assert.Equal(t, "swsh3-136", card.ID)
+}
+
+func TestFetchSingleCardCtxCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := NewFetcher(srv.Client(), 5*time.Second, srv.URL)
+	card, err := f.FetchSingleCardCtx(ctx, "swsh3-136")
+	assert.Nil(t, card)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestListCardTypesCtxDeadlineExceeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	f := NewFetcher(srv.Client(), 5*time.Second, srv.URL)
+	cardTypes, err := f.ListCardTypesCtx(ctx)
+	assert.Nil(t, cardTypes)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSearchCardsWithFilters(t *testing.T) {
+	var rawQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	retreat := 0
+	standard := true
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchCards(model.CardQueryOptions{
+		Types:          "Fire",
+		Stage:          "Stage2",
+		Hp:             150,
+		Rarity:         "Rare",
+		RegulationMark: "F",
+		Retreat:        &retreat,
+		SetId:          "swsh3",
+		LegalStandard:  &standard,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp=150&legal.standard=true&rarity=Rare&regulationMark=F&retreat=0&set.id=swsh3&stage=Stage2&types=Fire", rawQuery)
+}
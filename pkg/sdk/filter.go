@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// Filter constructors, to be used in the Filters of the query options:
+//
+//	model.CardQueryOptions{
+//		Filters: model.Filters{
+//			"hp":   sdk.Gte(150),
+//			"name": sdk.Like("pika*"),
+//		},
+//	}
+
+func Eq(value any) model.Filter {
+	return newFilter(model.FilterEq, value)
+}
+
+func Neq(value any) model.Filter {
+	return newFilter(model.FilterNeq, value)
+}
+
+func Gt(value any) model.Filter {
+	return newFilter(model.FilterGt, value)
+}
+
+func Gte(value any) model.Filter {
+	return newFilter(model.FilterGte, value)
+}
+
+func Lt(value any) model.Filter {
+	return newFilter(model.FilterLt, value)
+}
+
+func Lte(value any) model.Filter {
+	return newFilter(model.FilterLte, value)
+}
+
+// Like matches values containing pattern, "*" being a wildcard.
+func Like(pattern string) model.Filter {
+	return newFilter(model.FilterLike, pattern)
+}
+
+// Not matches values not containing value.
+func Not(value any) model.Filter {
+	return newFilter(model.FilterNot, value)
+}
+
+// IsNull matches cards where the field is not set.
+func IsNull() model.Filter {
+	return model.Filter{Operator: model.FilterNull}
+}
+
+func NotNull() model.Filter {
+	return model.Filter{Operator: model.FilterNotNull}
+}
+
+func newFilter(operator model.FilterOperator, value any) model.Filter {
+	return model.Filter{
+		Operator: operator,
+		Value:    fmt.Sprint(value),
+	}
+}
@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+func newQueryRecorder(rawQuery *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*rawQuery = r.URL.RawQuery
+		_, _ = w.Write([]byte(`[]`))
+	}))
+}
+
+func TestFilterString(t *testing.T) {
+	assert.Equal(t, "eq:Fire", Eq("Fire").String())
+	assert.Equal(t, "neq:Fire", Neq("Fire").String())
+	assert.Equal(t, "gt:100", Gt(100).String())
+	assert.Equal(t, "gte:150", Gte(150).String())
+	assert.Equal(t, "lt:2", Lt(2).String())
+	assert.Equal(t, "lte:2", Lte(2).String())
+	assert.Equal(t, "like:pika*", Like("pika*").String())
+	assert.Equal(t, "not:ex", Not("ex").String())
+	assert.Equal(t, "null:", IsNull().String())
+	assert.Equal(t, "notnull:", NotNull().String())
+	assert.Equal(t, "Fire", model.Filter{Value: "Fire"}.String())
+}
+
+func TestSearchCardsWithFilterOperators(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchCards(model.CardQueryOptions{
+		Stage: "Stage2",
+		Filters: model.Filters{
+			"hp":     Gte(150),
+			"types":  Eq("Fire"),
+			"name":   Like("char*"),
+			"effect": IsNull(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "effect=null%3A&hp=gte%3A150&name=like%3Achar%2A&stage=Stage2&types=eq%3AFire", rawQuery)
+}
+
+func TestSearchSetsWithFilterOperators(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchSets(model.SetQueryOptions{
+		Filters: model.Filters{
+			"cardCount.official": Gt(200),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "cardCount.official=gt%3A200", rawQuery)
+}
+
+func TestSearchSeriesWithFilterOperators(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchSeries(model.SerieQueryOptions{
+		Name: "Sword",
+		Filters: model.Filters{
+			"id": Neq("swshp"),
+		},
+		PaginationPage: 1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "id=neq%3Aswshp&name=Sword&pagination%3Apage=1", rawQuery)
+}
+
+func TestSearchConflictingFilter(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchSets(model.SetQueryOptions{
+		Name:    "Sword",
+		Filters: model.Filters{"name": Like("sword*")},
+	})
+	assert.ErrorIs(t, err, ErrConflictingFilter)
+
+	_, err = f.SearchCards(model.CardQueryOptions{
+		Types:   "Fire",
+		Filters: model.Filters{"hp": Gte(150)},
+		Sort:    model.Sort{{Field: "hp"}, {Field: "name"}},
+	})
+	assert.NoError(t, err)
+}
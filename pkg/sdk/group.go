@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// Grouper returns the cards having one value of the Lister lists, e.g. every
+// card drawn by an illustrator.
+type Grouper interface {
+	GetCardsByType(ctx context.Context, cardType string) (*model.CardGroup, error)
+	GetCardsByRarity(ctx context.Context, rarity string) (*model.CardGroup, error)
+	GetCardsByIllustrator(ctx context.Context, illustrator string) (*model.CardGroup, error)
+	GetCardsByCategory(ctx context.Context, category string) (*model.CardGroup, error)
+	GetCardsByStage(ctx context.Context, stage string) (*model.CardGroup, error)
+	GetCardsBySuffix(ctx context.Context, suffix string) (*model.CardGroup, error)
+	GetCardsByVariant(ctx context.Context, variant string) (*model.CardGroup, error)
+	GetCardsByEnergyType(ctx context.Context, energyType string) (*model.CardGroup, error)
+	GetCardsByTrainerType(ctx context.Context, trainerType string) (*model.CardGroup, error)
+	GetCardsByRegulationMark(ctx context.Context, regulationMark string) (*model.CardGroup, error)
+	GetCardsByHP(ctx context.Context, hp int) (*model.CardGroup, error)
+	GetCardsByRetreat(ctx context.Context, retreat int) (*model.CardGroup, error)
+	GetCardsByDexId(ctx context.Context, dexID int) (*model.CardGroup, error)
+}
+
+func (f *fetcher) GetCardsByType(ctx context.Context, cardType string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointTypes, cardType)
+}
+
+func (f *fetcher) GetCardsByRarity(ctx context.Context, rarity string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointRarities, rarity)
+}
+
+func (f *fetcher) GetCardsByIllustrator(ctx context.Context, illustrator string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointIllustrators, illustrator)
+}
+
+func (f *fetcher) GetCardsByCategory(ctx context.Context, category string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointCategories, category)
+}
+
+func (f *fetcher) GetCardsByStage(ctx context.Context, stage string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointStages, stage)
+}
+
+func (f *fetcher) GetCardsBySuffix(ctx context.Context, suffix string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointSuffixes, suffix)
+}
+
+func (f *fetcher) GetCardsByVariant(ctx context.Context, variant string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointVariants, variant)
+}
+
+func (f *fetcher) GetCardsByEnergyType(ctx context.Context, energyType string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointEnergyTypes, energyType)
+}
+
+func (f *fetcher) GetCardsByTrainerType(ctx context.Context, trainerType string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointTrainerTypes, trainerType)
+}
+
+func (f *fetcher) GetCardsByRegulationMark(ctx context.Context, regulationMark string) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointRegulationMarks, regulationMark)
+}
+
+func (f *fetcher) GetCardsByHP(ctx context.Context, hp int) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointHP, strconv.Itoa(hp))
+}
+
+func (f *fetcher) GetCardsByRetreat(ctx context.Context, retreat int) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointRetreats, strconv.Itoa(retreat))
+}
+
+func (f *fetcher) GetCardsByDexId(ctx context.Context, dexID int) (*model.CardGroup, error) {
+	return f.getCardGroup(ctx, EndpointDexIds, strconv.Itoa(dexID))
+}
+
+// getCardGroup sends value as a single path segment, so names with spaces,
+// accents or slashes reach the API unchanged.
+func (f *fetcher) getCardGroup(ctx context.Context, endpoint Endpoint, value string) (*model.CardGroup, error) {
+	group, err := do[model.CardGroup](ctx, f, newRequest(endpoint, value))
+	if err != nil {
+		return nil, fmt.Errorf("get cards by %s: %w", endpoint, err)
+	}
+
+	return &group, nil
+}
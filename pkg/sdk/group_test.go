@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// newGroupServer answers every request with body and records the escaped
+// request path.
+func newGroupServer(path *string, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*path = r.URL.EscapedPath()
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestGetCardsByIllustrator(t *testing.T) {
+	var path string
+	srv := newGroupServer(&path, `{"name":"Naoki Saitō","cards":[{"id":"swsh3-136","localId":"136","name":"Furret"}]}`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	group, err := f.GetCardsByIllustrator(context.Background(), "Naoki Saitō")
+	assert.NoError(t, err)
+	assert.Equal(t, "Naoki Saitō", group.Name)
+	assert.Equal(t, "swsh3-136", group.Cards[0].ID)
+	assert.Equal(t, "/en/illustrators/Naoki%20Sait%C5%8D", path)
+}
+
+func TestGetCardsByNumericName(t *testing.T) {
+	var path string
+	srv := newGroupServer(&path, `{"name":110,"cards":[{"id":"swsh3-136"}]}`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	group, err := f.GetCardsByHP(context.Background(), 110)
+	assert.NoError(t, err)
+	assert.Equal(t, "110", group.Name)
+	assert.Equal(t, "/en/hp/110", path)
+
+	_, err = f.GetCardsByDexId(context.Background(), 162)
+	assert.NoError(t, err)
+	assert.Equal(t, "/en/dex-ids/162", path)
+}
+
+func TestGetCardsByPathEscaping(t *testing.T) {
+	var path string
+	srv := newGroupServer(&path, `{"name":"","cards":[]}`)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	tests := []struct {
+		get   func(context.Context, string) (*model.CardGroup, error)
+		value string
+		path  string
+	}{
+		{f.GetCardsByType, "Fire", "/en/types/Fire"},
+		{f.GetCardsByRarity, "Rare Holo V", "/en/rarities/Rare%20Holo%20V"},
+		{f.GetCardsByStage, "Stage2", "/en/stages/Stage2"},
+		{f.GetCardsByTrainerType, "Rocket's Secret Machine", "/en/trainer-types/Rocket%27s%20Secret%20Machine"},
+		{f.GetCardsByIllustrator, "a/b?c#d", "/en/illustrators/a%2Fb%3Fc%23d"},
+	}
+	for _, tt := range tests {
+		_, err := tt.get(context.Background(), tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.path, path)
+	}
+}
+
+func TestGetCardsByNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	group, err := f.GetCardsByRegulationMark(context.Background(), "Z")
+	assert.Nil(t, group)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
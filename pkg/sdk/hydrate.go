@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+type Hydrator interface {
+	GetSetWithCards(ctx context.Context, setID string, options HydrateOptions) (*model.Set, error)
+	GetSerieWithSets(ctx context.Context, serieID string, options HydrateOptions) (*SerieWithSets, error)
+}
+
+// HydrateOptions tunes the hydration helpers. The zero value uses
+// DefaultConcurrency without progress reporting.
+type HydrateOptions struct {
+	Concurrency int
+	// Progress is called after each card, or each set for a serie, with the
+	// number of items done and the total, one call at a time.
+	Progress func(done, total int)
+	// Cards also hydrates the cards of the sets of a serie.
+	Cards bool
+}
+
+// SerieWithSets is a serie with its full sets instead of their briefs.
+type SerieWithSets struct {
+	ID   string      `json:"id"`
+	Logo string      `json:"logo"`
+	Name string      `json:"name"`
+	Sets []model.Set `json:"sets"`
+}
+
+// GetSetWithCards fetches the set then the full detail of every card. Cards
+// that fail keep the partial data from the set and their errors are returned
+// joined together with the set.
+func (f *fetcher) GetSetWithCards(ctx context.Context, setID string, options HydrateOptions) (*model.Set, error) {
+	set, err := f.GetSetsCtx(ctx, setID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.hydrateCards(ctx, []*model.Set{set}, options.Concurrency, newProgress(options.Progress)); err != nil {
+		return set, fmt.Errorf("hydrate set %s: %w", setID, err)
+	}
+
+	return set, nil
+}
+
+// GetSerieWithSets fetches the serie then every one of its sets, and their
+// cards when options.Cards is set. Sets or cards that fail keep their partial
+// data and the errors are returned joined together with the serie.
+func (f *fetcher) GetSerieWithSets(ctx context.Context, serieID string, options HydrateOptions) (*SerieWithSets, error) {
+	serie, err := f.GetSingleSerieCtx(ctx, serieID)
+	if err != nil {
+		return nil, err
+	}
+
+	hydrated := &SerieWithSets{
+		ID:   serie.ID,
+		Logo: serie.Logo,
+		Name: serie.Name,
+		Sets: make([]model.Set, len(serie.Sets)),
+	}
+
+	var (
+		mu       sync.Mutex
+		errs     []error
+		progress = newProgress(options.Progress)
+	)
+	forEachConcurrent(len(serie.Sets), options.Concurrency, func(i int) {
+		brief := serie.Sets[i]
+		set, err := f.GetSetsCtx(ctx, brief.ID)
+		if err == nil {
+			hydrated.Sets[i] = *set
+		} else {
+			hydrated.Sets[i] = model.Set{
+				ID:        brief.ID,
+				Name:      brief.Name,
+				Logo:      brief.Logo,
+				Symbol:    brief.Symbol,
+				CardCount: brief.CardCount,
+			}
+
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("get set %s: %w", brief.ID, err))
+			mu.Unlock()
+		}
+		progress(len(serie.Sets))
+	})
+
+	if options.Cards {
+		sets := make([]*model.Set, len(hydrated.Sets))
+		for i := range hydrated.Sets {
+			sets[i] = &hydrated.Sets[i]
+		}
+		if err := f.hydrateCards(ctx, sets, options.Concurrency, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return hydrated, fmt.Errorf("hydrate serie %s: %w", serieID, err)
+	}
+
+	return hydrated, nil
+}
+
+// hydrateCards replaces the cards of sets with their full detail. The cards
+// of every set share the same pool of workers.
+func (f *fetcher) hydrateCards(ctx context.Context, sets []*model.Set, concurrency int, progress func(total int)) error {
+	var (
+		cardIDs   []string
+		positions [][2]int
+	)
+	for i, set := range sets {
+		for j, card := range set.Cards {
+			cardIDs = append(cardIDs, card.ID)
+			positions = append(positions, [2]int{i, j})
+		}
+	}
+
+	var done func(CardResult)
+	if progress != nil {
+		done = func(CardResult) { progress(len(cardIDs)) }
+	}
+
+	var errs []error
+	for i, result := range f.fetchCards(ctx, cardIDs, concurrency, done) {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+			continue
+		}
+		sets[positions[i][0]].Cards[positions[i][1]] = *result.Card
+	}
+
+	return errors.Join(errs...)
+}
+
+// newProgress wraps report, which may be nil, into a function safe for
+// concurrent use counting the items done.
+func newProgress(report func(done, total int)) func(total int) {
+	if report == nil {
+		return func(int) {}
+	}
+
+	var (
+		mu    sync.Mutex
+		count int
+	)
+	return func(total int) {
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+		report(count, total)
+	}
+}
@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCatalogServer serves a small catalog where card swsh3-3 always fails.
+func newCatalogServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/en")
+		switch {
+		case path == "/series/swsh":
+			_, _ = w.Write([]byte(`{"id":"swsh","name":"Sword & Shield","sets":[{"id":"swsh1"},{"id":"swsh3"}]}`))
+		case path == "/sets/swsh1":
+			_, _ = w.Write([]byte(`{"id":"swsh1","name":"Sword & Shield","cards":[{"id":"swsh1-1","name":"Celebi V"}]}`))
+		case path == "/sets/swsh3":
+			_, _ = w.Write([]byte(`{"id":"swsh3","name":"Darkness Ablaze","cards":[{"id":"swsh3-1","name":"Butterfree V"},{"id":"swsh3-2","name":"Butterfree VMAX"},{"id":"swsh3-3","name":"Paras"}]}`))
+		case path == "/cards/swsh3-3":
+			w.WriteHeader(http.StatusInternalServerError)
+		case strings.HasPrefix(path, "/cards/"):
+			id := strings.TrimPrefix(path, "/cards/")
+			_, _ = fmt.Fprintf(w, `{"id":%q,"name":"full %s","hp":100,"attacks":[{"name":"Tackle"}]}`, id, id)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGetSetWithCards(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+
+	var progress [][2]int
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	set, err := f.GetSetWithCards(context.Background(), "swsh3", HydrateOptions{
+		Concurrency: 2,
+		Progress: func(done, total int) {
+			progress = append(progress, [2]int{done, total})
+		},
+	})
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Equal(t, "Darkness Ablaze", set.Name)
+	assert.Len(t, set.Cards, 3)
+	assert.Equal(t, "full swsh3-1", set.Cards[0].Name)
+	assert.Equal(t, "Tackle", set.Cards[1].Attacks[0].Name)
+	// the failed card keeps its partial data
+	assert.Equal(t, "Paras", set.Cards[2].Name)
+	assert.Equal(t, [][2]int{{1, 3}, {2, 3}, {3, 3}}, progress)
+}
+
+func TestGetSetWithCardsNotFound(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	set, err := f.GetSetWithCards(context.Background(), "swsh99", HydrateOptions{})
+	assert.Nil(t, set)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetSerieWithSets(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+
+	var progress [][2]int
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	serie, err := f.GetSerieWithSets(context.Background(), "swsh", HydrateOptions{
+		Concurrency: 1,
+		Progress: func(done, total int) {
+			progress = append(progress, [2]int{done, total})
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Sword & Shield", serie.Name)
+	assert.Len(t, serie.Sets, 2)
+	assert.Equal(t, "Darkness Ablaze", serie.Sets[1].Name)
+	// cards are left as returned by the set
+	assert.Equal(t, "Celebi V", serie.Sets[0].Cards[0].Name)
+	assert.Equal(t, [][2]int{{1, 2}, {2, 2}}, progress)
+}
+
+func TestGetSerieWithSetsAndCards(t *testing.T) {
+	srv := newCatalogServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	serie, err := f.GetSerieWithSets(context.Background(), "swsh", HydrateOptions{Cards: true})
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Equal(t, "full swsh1-1", serie.Sets[0].Cards[0].Name)
+	assert.Equal(t, "full swsh3-2", serie.Sets[1].Cards[1].Name)
+	assert.Equal(t, "Paras", serie.Sets[1].Cards[2].Name)
+}
@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// DefaultPageSize is used by the iterators when the options do not set
+// PaginationItemsPerPage.
+const DefaultPageSize = 100
+
+// Paginator walks every page of the search endpoints. Pages are fetched
+// lazily, the iteration stops after an empty or short page, on the first
+// error, or when the caller breaks out of the loop.
+type Paginator interface {
+	AllCards(ctx context.Context, options model.CardQueryOptions) iter.Seq2[model.CardBrief, error]
+	AllSets(ctx context.Context, options model.SetQueryOptions) iter.Seq2[model.SetBrief, error]
+	AllSeries(ctx context.Context, options model.SerieQueryOptions) iter.Seq2[model.SerieBrief, error]
+}
+
+func (f *fetcher) AllCards(ctx context.Context, options model.CardQueryOptions) iter.Seq2[model.CardBrief, error] {
+	return paginate(ctx, options.PaginationPage, options.PaginationItemsPerPage, func(ctx context.Context, page, itemsPerPage int) ([]model.CardBrief, error) {
+		options.PaginationPage = page
+		options.PaginationItemsPerPage = itemsPerPage
+		return f.SearchCardsCtx(ctx, options)
+	})
+}
+
+func (f *fetcher) AllSets(ctx context.Context, options model.SetQueryOptions) iter.Seq2[model.SetBrief, error] {
+	return paginate(ctx, options.PaginationPage, options.PaginationItemsPerPage, func(ctx context.Context, page, itemsPerPage int) ([]model.SetBrief, error) {
+		options.PaginationPage = page
+		options.PaginationItemsPerPage = itemsPerPage
+		return f.SearchSetsCtx(ctx, options)
+	})
+}
+
+func (f *fetcher) AllSeries(ctx context.Context, options model.SerieQueryOptions) iter.Seq2[model.SerieBrief, error] {
+	return paginate(ctx, options.PaginationPage, options.PaginationItemsPerPage, func(ctx context.Context, page, itemsPerPage int) ([]model.SerieBrief, error) {
+		options.PaginationPage = page
+		options.PaginationItemsPerPage = itemsPerPage
+		return f.SearchSeriesCtx(ctx, options)
+	})
+}
+
+func paginate[T any](ctx context.Context, page, itemsPerPage int, fetchPage func(ctx context.Context, page, itemsPerPage int) ([]T, error)) iter.Seq2[T, error] {
+	page = max(page, 1)
+	if itemsPerPage <= 0 {
+		itemsPerPage = DefaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		for ; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, err := fetchPage(ctx, page, itemsPerPage)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) < itemsPerPage {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+func newPaginatedServer(total int, requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("pagination:page"))
+		itemsPerPage, _ := strconv.Atoi(r.URL.Query().Get("pagination:itemsPerPage"))
+
+		items := []map[string]string{}
+		for i := (page - 1) * itemsPerPage; i < min(page*itemsPerPage, total); i++ {
+			items = append(items, map[string]string{"id": strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+}
+
+func TestAllCards(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPaginatedServer(5, &requests)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	var ids []string
+	for card, err := range f.AllCards(context.Background(), model.CardQueryOptions{PaginationItemsPerPage: 2}) {
+		assert.NoError(t, err)
+		ids = append(ids, card.ID)
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestAllSetsStopsOnEmptyPage(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPaginatedServer(4, &requests)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	count := 0
+	for _, err := range f.AllSets(context.Background(), model.SetQueryOptions{PaginationItemsPerPage: 2}) {
+		assert.NoError(t, err)
+		count++
+	}
+	assert.Equal(t, 4, count)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestAllSeriesEarlyBreak(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPaginatedServer(100, &requests)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	count := 0
+	for _, err := range f.AllSeries(context.Background(), model.SerieQueryOptions{PaginationItemsPerPage: 10}) {
+		assert.NoError(t, err)
+		count++
+		if count == 10 {
+			break
+		}
+	}
+	assert.Equal(t, 10, count)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestAllCardsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	count := 0
+	for _, err := range f.AllCards(context.Background(), model.CardQueryOptions{}) {
+		assert.ErrorIs(t, err, ErrServer)
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestAllCardsCanceled(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPaginatedServer(100, &requests)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lastErr error
+	for _, err := range f.AllCards(ctx, model.CardQueryOptions{PaginationItemsPerPage: 10}) {
+		cancel()
+		lastErr = err
+	}
+	assert.ErrorIs(t, lastErr, context.Canceled)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Language is a TCGdex locale, used as the first path segment of every
+// endpoint.
+type Language string
+
+const (
+	LanguageEnglish            Language = "en"
+	LanguageFrench             Language = "fr"
+	LanguageSpanish            Language = "es"
+	LanguageSpanishMexico      Language = "es-mx"
+	LanguageItalian            Language = "it"
+	LanguagePortuguese         Language = "pt"
+	LanguagePortugueseBrazil   Language = "pt-br"
+	LanguagePortuguesePortugal Language = "pt-pt"
+	LanguageGerman             Language = "de"
+	LanguageDutch              Language = "nl"
+	LanguagePolish             Language = "pl"
+	LanguageRussian            Language = "ru"
+	LanguageJapanese           Language = "ja"
+	LanguageKorean             Language = "ko"
+	LanguageChineseTraditional Language = "zh-tw"
+	LanguageChineseSimplified  Language = "zh-cn"
+	LanguageIndonesian         Language = "id"
+	LanguageThai               Language = "th"
+)
+
+const DefaultLanguage = LanguageEnglish
+
+var languages = []Language{
+	LanguageEnglish,
+	LanguageFrench,
+	LanguageSpanish,
+	LanguageSpanishMexico,
+	LanguageItalian,
+	LanguagePortuguese,
+	LanguagePortugueseBrazil,
+	LanguagePortuguesePortugal,
+	LanguageGerman,
+	LanguageDutch,
+	LanguagePolish,
+	LanguageRussian,
+	LanguageJapanese,
+	LanguageKorean,
+	LanguageChineseTraditional,
+	LanguageChineseSimplified,
+	LanguageIndonesian,
+	LanguageThai,
+}
+
+// Languages returns every locale served by TCGdex.
+func Languages() []Language {
+	return append([]Language(nil), languages...)
+}
+
+func (l Language) Valid() bool {
+	for _, lang := range languages {
+		if l == lang {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseLanguage parses a locale such as "fr" or "zh-TW".
+func ParseLanguage(s string) (Language, error) {
+	lang := Language(strings.ToLower(strings.TrimSpace(s)))
+	if !lang.Valid() {
+		return "", fmt.Errorf("unknown language %q", s)
+	}
+
+	return lang, nil
+}
+
+type languageContextKey struct{}
+
+// ContextWithLanguage overrides the client language for the requests made
+// with the returned context.
+func ContextWithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+func languageFromContext(ctx context.Context) (Language, bool) {
+	lang, ok := ctx.Value(languageContextKey{}).(Language)
+	return lang, ok && lang != ""
+}
+
+// splitLanguage splits a trailing language segment off baseURL, as used by
+// NewFetcher (e.g. https://api.tcgdex.net/v2/en).
+func splitLanguage(baseURL string) (string, Language) {
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	i := strings.LastIndex(baseURL, "/")
+	if i < 0 {
+		return baseURL, ""
+	}
+
+	lang := Language(baseURL[i+1:])
+	if !lang.Valid() {
+		return baseURL, ""
+	}
+
+	return baseURL[:i], lang
+}
@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLanguage(t *testing.T) {
+	lang, err := ParseLanguage("zh-TW")
+	assert.NoError(t, err)
+	assert.Equal(t, LanguageChineseTraditional, lang)
+
+	_, err = ParseLanguage("xx")
+	assert.Error(t, err)
+}
+
+func TestLanguagesAreValid(t *testing.T) {
+	for _, lang := range Languages() {
+		assert.True(t, lang.Valid(), lang)
+	}
+	assert.False(t, Language("").Valid())
+}
+
+func TestNewFetcherSplitsLanguage(t *testing.T) {
+	f := NewFetcher(nil, 5*time.Second, "https://api.tcgdex.net/v2/ja").(*fetcher)
+	assert.Equal(t, "https://api.tcgdex.net/v2", f.baseURL)
+	assert.Equal(t, LanguageJapanese, f.language)
+
+	f = NewFetcher(nil, 5*time.Second, "https://tcgdex.example.com/api").(*fetcher)
+	assert.Equal(t, "https://tcgdex.example.com/api", f.baseURL)
+	assert.Equal(t, Language(""), f.language)
+}
+
+func TestContextWithLanguage(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":"swsh3-136"}`))
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.FetchSingleCardCtx(context.Background(), "swsh3-136")
+	assert.NoError(t, err)
+	_, err = f.FetchSingleCardCtx(ContextWithLanguage(context.Background(), LanguageFrench), "swsh3-136")
+	assert.NoError(t, err)
+	_, err = f.FetchSingleCardCtx(ContextWithLanguage(context.Background(), LanguageJapanese), "swsh3-136")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"/en/cards/swsh3-136", "/fr/cards/swsh3-136", "/ja/cards/swsh3-136"}, paths)
+}
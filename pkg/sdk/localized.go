@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+type Localizer interface {
+	FetchCardLocalized(ctx context.Context, cardID string, langs ...Language) (*model.Card, Language, error)
+	FetchCardTranslations(ctx context.Context, cardID string, langs ...Language) (map[Language]model.Card, error)
+}
+
+// FetchCardLocalized tries langs in preference order and returns the first
+// card found together with its language. Only not found errors fall through
+// to the next language. Without langs the client language is used.
+func (f *fetcher) FetchCardLocalized(ctx context.Context, cardID string, langs ...Language) (*model.Card, Language, error) {
+	if len(langs) == 0 {
+		langs = []Language{f.language}
+	}
+
+	var lastErr error
+	for _, lang := range langs {
+		card, err := f.FetchSingleCardCtx(ContextWithLanguage(ctx, lang), cardID)
+		if err == nil {
+			return card, lang, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, "", fmt.Errorf("fetch card localized %s: %w", lang, err)
+		}
+		lastErr = err
+	}
+
+	return nil, "", fmt.Errorf("fetch card localized: %w", lastErr)
+}
+
+// FetchCardTranslations fetches the card in every given language, or every
+// known language without langs. Languages where the card does not exist are
+// left out of the map. On other failures the cards fetched so far are
+// returned along with the joined errors.
+func (f *fetcher) FetchCardTranslations(ctx context.Context, cardID string, langs ...Language) (map[Language]model.Card, error) {
+	if len(langs) == 0 {
+		langs = Languages()
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		cards = make(map[Language]model.Card, len(langs))
+		errs  []error
+	)
+	for _, lang := range langs {
+		wg.Add(1)
+		go func(lang Language) {
+			defer wg.Done()
+
+			card, err := f.FetchSingleCardCtx(ContextWithLanguage(ctx, lang), cardID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				cards[lang] = *card
+			case !errors.Is(err, ErrNotFound):
+				errs = append(errs, fmt.Errorf("fetch card translation %s: %w", lang, err))
+			}
+		}(lang)
+	}
+	wg.Wait()
+
+	return cards, errors.Join(errs...)
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLocalizedServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fr/cards/swsh3-136":
+			_, _ = w.Write([]byte(`{"id":"swsh3-136","name":"Fulgudog"}`))
+		case "/en/cards/swsh3-136":
+			_, _ = w.Write([]byte(`{"id":"swsh3-136","name":"Boltund"}`))
+		case "/de/cards/swsh3-136":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestFetchCardLocalized(t *testing.T) {
+	srv := newLocalizedServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	card, lang, err := f.FetchCardLocalized(context.Background(), "swsh3-136", LanguageJapanese, LanguageFrench, LanguageEnglish)
+	assert.NoError(t, err)
+	assert.Equal(t, LanguageFrench, lang)
+	assert.Equal(t, "Fulgudog", card.Name)
+}
+
+func TestFetchCardLocalizedNotFound(t *testing.T) {
+	srv := newLocalizedServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	card, lang, err := f.FetchCardLocalized(context.Background(), "swsh3-136", LanguageJapanese, LanguageKorean)
+	assert.Nil(t, card)
+	assert.Empty(t, lang)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFetchCardLocalizedStopsOnServerError(t *testing.T) {
+	srv := newLocalizedServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	card, _, err := f.FetchCardLocalized(context.Background(), "swsh3-136", LanguageGerman, LanguageEnglish)
+	assert.Nil(t, card)
+	assert.ErrorIs(t, err, ErrServer)
+}
+
+func TestFetchCardTranslations(t *testing.T) {
+	srv := newLocalizedServer()
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	cards, err := f.FetchCardTranslations(context.Background(), "swsh3-136", LanguageEnglish, LanguageFrench, LanguageJapanese)
+	assert.NoError(t, err)
+	assert.Len(t, cards, 2)
+	assert.Equal(t, "Boltund", cards[LanguageEnglish].Name)
+	assert.Equal(t, "Fulgudog", cards[LanguageFrench].Name)
+
+	cards, err = f.FetchCardTranslations(context.Background(), "swsh3-136")
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Len(t, cards, 2)
+}
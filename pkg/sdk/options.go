@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://api.tcgdex.net/v2"
+	DefaultTimeout = 10 * time.Second
+)
+
+type config struct {
+	httpClient  *http.Client
+	timeout     time.Duration
+	timeoutSet  bool
+	baseURL     string
+	language    Language
+	userAgent   string
+	headers     http.Header
+	logger      *slog.Logger
+	retry       *RetryPolicy
+	rateLimiter RateLimiter
+	hooks       []Hooks
+
+	deduplication bool
+
+	cache            Cache
+	cacheTTL         time.Duration
+	cacheStaleTTL    time.Duration
+	endpointCacheTTL map[Endpoint]time.Duration
+}
+
+// Option configures a client created with New.
+type Option func(*config)
+
+// WithHTTPClient sets the HTTP client used for every request. The client is
+// copied when combined with WithTimeout, so the caller's value is never
+// modified.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = client
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client. A zero timeout means no
+// timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+		c.timeoutSet = true
+	}
+}
+
+// WithBaseURL sets the API root, without the language segment.
+func WithBaseURL(baseURL string) Option {
+	return func(c *config) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithLanguage sets the language appended to the base URL. An empty language
+// leaves the base URL untouched.
+func WithLanguage(lang Language) Option {
+	return func(c *config) {
+		c.language = lang
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeaders adds headers sent with every request.
+func WithHeaders(headers http.Header) Option {
+	return func(c *config) {
+		for key, values := range headers {
+			for _, value := range values {
+				c.headers.Add(key, value)
+			}
+		}
+	}
+}
+
+// WithLogger sets the logger used to trace requests at debug level.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		timeout:  DefaultTimeout,
+		baseURL:  DefaultBaseURL,
+		language: DefaultLanguage,
+		headers:  make(http.Header),
+
+		deduplication: true,
+
+		cacheTTL:         DefaultCacheTTL,
+		cacheStaleTTL:    DefaultCacheStaleTTL,
+		endpointCacheTTL: map[Endpoint]time.Duration{EndpointRandom: 0},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	switch {
+	case cfg.httpClient == nil:
+		cfg.httpClient = &http.Client{
+			Timeout: cfg.timeout,
+		}
+	case cfg.timeoutSet:
+		client := *cfg.httpClient
+		client.Timeout = cfg.timeout
+		cfg.httpClient = &client
+	}
+
+	if cfg.logger == nil {
+		cfg.logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
+	return cfg
+}
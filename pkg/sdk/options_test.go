@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDefaults(t *testing.T) {
+	f := New().(*fetcher)
+	assert.Equal(t, "https://api.tcgdex.net/v2", f.baseURL)
+	assert.Equal(t, LanguageEnglish, f.language)
+	assert.Equal(t, DefaultTimeout, f.httpClient.Timeout)
+}
+
+func TestNewWithOptions(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		_, _ = w.Write([]byte(`["Fire"]`))
+	}))
+	defer srv.Close()
+
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL+"/v2/"),
+		WithLanguage("fr"),
+		WithUserAgent("tcgdex-test/1.0"),
+		WithHeaders(http.Header{"X-Trace-Id": {"abc"}}),
+	)
+	cardTypes, err := f.ListCardTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Fire"}, cardTypes)
+	assert.Equal(t, "/v2/fr/types", got.URL.Path)
+	assert.Equal(t, "tcgdex-test/1.0", got.Header.Get("User-Agent"))
+	assert.Equal(t, "abc", got.Header.Get("X-Trace-Id"))
+}
+
+func TestNewWithTimeoutCopiesClient(t *testing.T) {
+	client := &http.Client{}
+
+	f := New(WithHTTPClient(client), WithTimeout(3*time.Second)).(*fetcher)
+	assert.Equal(t, 3*time.Second, f.httpClient.Timeout)
+	assert.Zero(t, client.Timeout)
+}
+
+func TestNewWithHTTPClientKeepsTimeout(t *testing.T) {
+	client := &http.Client{Timeout: time.Minute}
+
+	f := New(WithHTTPClient(client)).(*fetcher)
+	assert.Same(t, client, f.httpClient)
+}
+
+func TestNewFetcherAppliesTimeout(t *testing.T) {
+	f := NewFetcher(http.DefaultClient, 5*time.Second, "https://api.tcgdex.net/v2/en").(*fetcher)
+	assert.Equal(t, 5*time.Second, f.httpClient.Timeout)
+	assert.Zero(t, http.DefaultClient.Timeout)
+}
+
+func TestNewFetcherZeroTimeoutKeepsClientTimeout(t *testing.T) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	f := NewFetcher(client, 0, "https://api.tcgdex.net/v2/en").(*fetcher)
+	assert.Same(t, client, f.httpClient)
+	assert.Equal(t, 30*time.Second, f.httpClient.Timeout)
+}
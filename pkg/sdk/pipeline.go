@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Hooks are called around every request of the client, whatever the
+// endpoint. Both functions are optional.
+type Hooks struct {
+	// BeforeRequest may modify the request before it goes through the
+	// deduplication, the cache and the retries. It runs for every caller, and
+	// concurrent requests only share a response when their headers are equal
+	// once the hooks ran. An error aborts the request.
+	BeforeRequest func(endpoint Endpoint, req *http.Request) error
+	// AfterResponse is called with the outcome of the request, cache hits
+	// included. It must not read the response body.
+	AfterResponse func(event ResponseEvent)
+}
+
+// ResponseEvent describes a completed request. Response is nil when Err is
+// set.
+type ResponseEvent struct {
+	Endpoint Endpoint
+	Request  *http.Request
+	Response *http.Response
+	Err      error
+	Duration time.Duration
+}
+
+// WithHooks adds hooks to the client. Several hooks run in the order they
+// were added.
+func WithHooks(hooks Hooks) Option {
+	return func(c *config) {
+		c.hooks = append(c.hooks, hooks)
+	}
+}
+
+// do is the pipeline shared by every endpoint: it sends r and decodes the
+// JSON response into a T.
+func do[T any](ctx context.Context, f *fetcher, r request) (T, error) {
+	var target T
+
+	httpResp, err := f.get(ctx, r)
+	if err != nil {
+		return target, err
+	}
+	defer httpResp.Body.Close()
+
+	if err := decodeJSONResponse(httpResp, &target); err != nil {
+		return target, fmt.Errorf("decode json response: %w", err)
+	}
+
+	return target, nil
+}
+
+// get builds the HTTP request of r and sends it through the hooks, the
+// deduplication, the cache and the retries.
+func (f *fetcher) get(ctx context.Context, r request) (*http.Response, error) {
+	url, err := r.url(f.languageBaseURL(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("build url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+
+	for key, values := range f.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	if f.userAgent != "" {
+		req.Header.Set("User-Agent", f.userAgent)
+	}
+
+	for _, hooks := range f.hooks {
+		if hooks.BeforeRequest == nil {
+			continue
+		}
+		if err := hooks.BeforeRequest(r.endpoint, req); err != nil {
+			return nil, fmt.Errorf("before request: %w", err)
+		}
+	}
+
+	start := time.Now()
+	resp, err := f.dispatch(req, r.endpoint)
+
+	event := ResponseEvent{
+		Endpoint: r.endpoint,
+		Request:  req,
+		Response: resp,
+		Err:      err,
+		Duration: time.Since(start),
+	}
+	for _, hooks := range f.hooks {
+		if hooks.AfterResponse != nil {
+			hooks.AfterResponse(event)
+		}
+	}
+
+	return resp, err
+}
+
+// dispatch shares req with identical requests in flight, then goes through
+// the cache and the retries.
+func (f *fetcher) dispatch(req *http.Request, endpoint Endpoint) (*http.Response, error) {
+	// each caller of a random endpoint expects its own pick
+	if f.flights != nil && endpoint != EndpointRandom {
+		return f.flights.do(req, func(req *http.Request) (*http.Response, error) {
+			return f.doEndpoint(req, endpoint)
+		})
+	}
+
+	return f.doEndpoint(req, endpoint)
+}
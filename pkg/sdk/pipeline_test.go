@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/cache"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// eventRecorder is an AfterResponse hook safe for concurrent use.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []ResponseEvent
+}
+
+func (r *eventRecorder) record(event ResponseEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *eventRecorder) endpoints() []Endpoint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	endpoints := make([]Endpoint, len(r.events))
+	for i, event := range r.events {
+		endpoints[i] = event.Endpoint
+	}
+	return endpoints
+}
+
+func TestHooksApplyToEveryEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/en/types", "/en/cards":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	var recorder eventRecorder
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithHooks(Hooks{AfterResponse: recorder.record}))
+
+	ctx := context.Background()
+	_, err := f.FetchSingleCardCtx(ctx, "swsh3-136")
+	assert.NoError(t, err)
+	_, err = f.SearchCardsCtx(ctx, model.CardQueryOptions{})
+	assert.NoError(t, err)
+	_, err = f.ListCardTypesCtx(ctx)
+	assert.NoError(t, err)
+	_, err = f.GetCardsByIllustrator(ctx, "Mitsuhiro Arita")
+	assert.NoError(t, err)
+	_, err = f.RandomSerie(ctx, model.SerieQueryOptions{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Endpoint{EndpointCards, EndpointCards, EndpointTypes, EndpointIllustrators, EndpointRandom}, recorder.endpoints())
+	for _, event := range recorder.events {
+		assert.NoError(t, event.Err)
+		assert.Equal(t, http.StatusOK, event.Response.StatusCode)
+	}
+}
+
+func TestBeforeRequestHook(t *testing.T) {
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`["Fire"]`))
+	}))
+	defer srv.Close()
+
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithHooks(Hooks{
+			BeforeRequest: func(endpoint Endpoint, req *http.Request) error {
+				req.Header.Set("Authorization", "Bearer "+string(endpoint))
+				return nil
+			},
+		}),
+	)
+
+	_, err := f.ListCardTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer types", authorization)
+}
+
+func TestBeforeRequestHookAborts(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	errBlocked := errors.New("blocked")
+	var recorder eventRecorder
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithHooks(Hooks{
+			BeforeRequest: func(Endpoint, *http.Request) error { return errBlocked },
+		}),
+		WithHooks(Hooks{AfterResponse: recorder.record}),
+	)
+
+	_, err := f.ListCardTypes()
+	assert.ErrorIs(t, err, errBlocked)
+	assert.Zero(t, calls.Load())
+	assert.Empty(t, recorder.endpoints())
+}
+
+func TestAfterResponseHookSeesCacheHitsAndErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := newCountingServer(&calls)
+	defer srv.Close()
+
+	var recorder eventRecorder
+	f := New(
+		WithHTTPClient(srv.Client()),
+		WithBaseURL(srv.URL),
+		WithCache(cache.NewLRU(10)),
+		WithHooks(Hooks{AfterResponse: recorder.record}),
+	)
+
+	for range 2 {
+		_, err := f.ListCardTypes()
+		assert.NoError(t, err)
+	}
+	_, err := f.GetSets("notfound")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.EqualValues(t, 2, calls.Load())
+	assert.Len(t, recorder.events, 3)
+	assert.Equal(t, http.StatusOK, recorder.events[1].Response.StatusCode)
+	assert.Equal(t, http.StatusNotFound, recorder.events[2].Response.StatusCode)
+	assert.Equal(t, EndpointSets, recorder.events[2].Endpoint)
+	assert.Equal(t, "/en/sets/notfound", recorder.events[2].Request.URL.Path)
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// Randomizer picks a random card, set or serie among the ones matching the
+// filters of the options, the zero value picking among all of them. Sorting
+// and pagination are ignored.
+type Randomizer interface {
+	RandomCard(ctx context.Context, options model.CardQueryOptions) (*model.Card, error)
+	RandomSet(ctx context.Context, options model.SetQueryOptions) (*model.Set, error)
+	RandomSerie(ctx context.Context, options model.SerieQueryOptions) (*model.Serie, error)
+}
+
+func (f *fetcher) RandomCard(ctx context.Context, options model.CardQueryOptions) (*model.Card, error) {
+	options.Sort, options.PaginationPage, options.PaginationItemsPerPage = nil, 0, 0
+	return getRandom[model.Card](ctx, f, "card", options)
+}
+
+func (f *fetcher) RandomSet(ctx context.Context, options model.SetQueryOptions) (*model.Set, error) {
+	options.Sort, options.PaginationPage, options.PaginationItemsPerPage = nil, 0, 0
+	return getRandom[model.Set](ctx, f, "set", options)
+}
+
+func (f *fetcher) RandomSerie(ctx context.Context, options model.SerieQueryOptions) (*model.Serie, error) {
+	options.Sort, options.PaginationPage, options.PaginationItemsPerPage = nil, 0, 0
+	return getRandom[model.Serie](ctx, f, "serie", options)
+}
+
+func getRandom[T any](ctx context.Context, f *fetcher, resource string, options any) (*T, error) {
+	req, err := newRequest(EndpointRandom, resource).withQuery(options)
+	if err != nil {
+		return nil, fmt.Errorf("values: %w", err)
+	}
+
+	target, err := do[T](ctx, f, req)
+	if err != nil {
+		return nil, fmt.Errorf("random %s: %w", resource, err)
+	}
+
+	return &target, nil
+}
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/cache"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+// newRandomServer returns a different id on every request and records the
+// last request URI.
+func newRandomServer(requestURI *string) *httptest.Server {
+	var calls atomic.Int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requestURI = r.URL.RequestURI()
+		_, _ = fmt.Fprintf(w, `{"id":"random-%d","name":"Random"}`, calls.Add(1))
+	}))
+}
+
+func TestRandomCard(t *testing.T) {
+	var requestURI string
+	srv := newRandomServer(&requestURI)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	card, err := f.RandomCard(context.Background(), model.CardQueryOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "random-1", card.ID)
+	assert.Equal(t, "/en/random/card", requestURI)
+
+	_, err = f.RandomCard(context.Background(), model.CardQueryOptions{
+		Types:          "Fire",
+		Filters:        model.Filters{"hp": Gte(100)},
+		Sort:           model.Sort{{Field: "hp"}},
+		PaginationPage: 2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/en/random/card?hp=gte%3A100&types=Fire", requestURI)
+}
+
+func TestRandomSetAndSerie(t *testing.T) {
+	var requestURI string
+	srv := newRandomServer(&requestURI)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithLanguage(LanguageFrench))
+	set, err := f.RandomSet(context.Background(), model.SetQueryOptions{Filters: model.Filters{"serie.id": Eq("swsh")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "random-1", set.ID)
+	assert.Equal(t, "/fr/random/set?serie.id=eq%3Aswsh", requestURI)
+
+	serie, err := f.RandomSerie(context.Background(), model.SerieQueryOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "random-2", serie.ID)
+	assert.Equal(t, "/fr/random/serie", requestURI)
+}
+
+func TestRandomCardNotCached(t *testing.T) {
+	var requestURI string
+	srv := newRandomServer(&requestURI)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithCache(cache.NewLRU(10)))
+	first, err := f.RandomCard(context.Background(), model.CardQueryOptions{})
+	assert.NoError(t, err)
+	second, err := f.RandomCard(context.Background(), model.CardQueryOptions{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+}
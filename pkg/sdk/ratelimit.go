@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter blocks until a request may be sent. It is satisfied by
+// golang.org/x/time/rate.Limiter as well as TokenBucket.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter allowing rps requests per second on average,
+// with bursts of up to burst requests.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a full bucket. A non positive rps disables limiting.
+func NewTokenBucket(rps float64, burst int) *TokenBucket {
+	burst = max(burst, 1)
+
+	return &TokenBucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if b.rate <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		// give the reserved token back, the request is not sent
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// WithRateLimiter makes every request, retries included, wait on limiter.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *config) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithRateLimit is a shorthand for WithRateLimiter(NewTokenBucket(rps, burst)).
+func WithRateLimit(rps float64, burst int) Option {
+	return WithRateLimiter(NewTokenBucket(rps, burst))
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketBurst(t *testing.T) {
+	b := NewTokenBucket(20, 3)
+
+	start := time.Now()
+	for range 3 {
+		assert.NoError(t, b.Wait(context.Background()))
+	}
+
+	// the burst is spent, the fourth token takes a refill
+	assert.NoError(t, b.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestTokenBucketCanceled(t *testing.T) {
+	b := NewTokenBucket(1, 1)
+	assert.NoError(t, b.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, b.Wait(ctx), context.DeadlineExceeded)
+}
+
+type countingLimiter struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls++
+	return nil
+}
+
+func TestFetcherUsesRateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	limiter := &countingLimiter{}
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRateLimiter(limiter))
+	_, err := f.ListCardTypes()
+	assert.NoError(t, err)
+	_, err = f.ListVariants()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, limiter.calls)
+}
+
+func TestFetcherRateLimitCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRateLimit(1, 1))
+	_, err := f.ListCardTypes()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = f.ListCardTypesCtx(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/go-querystring/query"
+)
+
+// request is a GET request to the API: the endpoint, the path segments under
+// it and the query string. Segments are validated and escaped when the URL is
+// built, so an ID can never change the shape of the path.
+type request struct {
+	endpoint Endpoint
+	segments []string
+	query    url.Values
+}
+
+func newRequest(endpoint Endpoint, segments ...string) request {
+	return request{endpoint: endpoint, segments: segments}
+}
+
+// withQuery encodes options, a struct with url tags, as the query string. A
+// key set both by a typed field and in Filters is rejected, the API would
+// silently pick one of the values.
+func (r request) withQuery(options any) (request, error) {
+	values, err := query.Values(options)
+	if err != nil {
+		return request{}, err
+	}
+
+	for key, vals := range values {
+		if len(vals) > 1 && key != "sort:field" && key != "sort:order" {
+			return request{}, fmt.Errorf("%w: %q is set both as a field and in Filters", ErrConflictingFilter, key)
+		}
+	}
+
+	r.query = values
+	return r, nil
+}
+
+// url returns the URL of the request under baseURL.
+func (r request) url(baseURL string) (*url.URL, error) {
+	var b strings.Builder
+	b.WriteString(baseURL)
+	b.WriteString("/")
+	b.WriteString(string(r.endpoint))
+	for _, segment := range r.segments {
+		if err := validateID(segment); err != nil {
+			return nil, err
+		}
+		b.WriteString("/")
+		b.WriteString(url.PathEscape(segment))
+	}
+	if len(r.query) > 0 {
+		b.WriteString("?")
+		b.WriteString(r.query.Encode())
+	}
+
+	return url.Parse(b.String())
+}
+
+// validateID rejects the path segments that escaping alone does not make
+// safe. Other characters, slashes included, are escaped.
+func validateID(id string) error {
+	switch {
+	case id == "":
+		return fmt.Errorf("%w: empty", ErrInvalidID)
+	case id == "." || id == "..":
+		return fmt.Errorf("%w: %q", ErrInvalidID, id)
+	case !utf8.ValidString(id):
+		return fmt.Errorf("%w: %q is not valid UTF-8", ErrInvalidID, id)
+	case strings.ContainsFunc(id, unicode.IsControl):
+		return fmt.Errorf("%w: %q contains control characters", ErrInvalidID, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+func TestRequestURL(t *testing.T) {
+	const baseURL = "https://api.tcgdex.net/v2/en"
+
+	tests := []struct {
+		name string
+		req  request
+		want string
+	}{
+		{"card", newRequest(EndpointCards, "swsh3-136"), baseURL + "/cards/swsh3-136"},
+		{"set card", newRequest(EndpointSets, "swsh3", "136"), baseURL + "/sets/swsh3/136"},
+		{"list", newRequest(EndpointTypes), baseURL + "/types"},
+		{"slash", newRequest(EndpointCards, "../sets/swsh3"), baseURL + "/cards/..%2Fsets%2Fswsh3"},
+		{"query", newRequest(EndpointCards, "swsh3-136?lang=fr"), baseURL + "/cards/swsh3-136%3Flang=fr"},
+		{"fragment", newRequest(EndpointCards, "swsh3#136"), baseURL + "/cards/swsh3%23136"},
+		{"space", newRequest(EndpointSeries, "sword shield"), baseURL + "/series/sword%20shield"},
+		{"percent", newRequest(EndpointSets, "100%"), baseURL + "/sets/100%25"},
+		{"encoded slash", newRequest(EndpointSets, "a%2Fb"), baseURL + "/sets/a%252Fb"},
+		{"accent", newRequest(EndpointIllustrators, "Naoki Saitō"), baseURL + "/illustrators/Naoki%20Sait%C5%8D"},
+		{"dots inside", newRequest(EndpointCards, "...."), baseURL + "/cards/...."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, err := tt.req.url(baseURL)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, url.String())
+		})
+	}
+}
+
+func TestRequestURLInvalidID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{"empty", ""},
+		{"dot", "."},
+		{"dot dot", ".."},
+		{"newline", "swsh3\n136"},
+		{"carriage return", "swsh3\r\nHost: evil"},
+		{"nul", "swsh3\x00"},
+		{"tab", "\tswsh3"},
+		{"delete", "swsh3\x7f"},
+		{"invalid utf-8", "swsh3\xff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRequest(EndpointCards, tt.id).url("https://api.tcgdex.net/v2/en")
+			assert.ErrorIs(t, err, ErrInvalidID)
+		})
+	}
+}
+
+func TestRequestURLQuery(t *testing.T) {
+	req, err := newRequest(EndpointCards).withQuery(model.CardQueryOptions{Name: "pika chu", PaginationPage: 1})
+	assert.NoError(t, err)
+
+	url, err := req.url("https://api.tcgdex.net/v2/en")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.tcgdex.net/v2/en/cards?name=pika+chu&pagination%3Apage=1", url.String())
+
+	req, err = newRequest(EndpointCards).withQuery(model.CardQueryOptions{})
+	assert.NoError(t, err)
+
+	url, err = req.url("https://api.tcgdex.net/v2/en")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.tcgdex.net/v2/en/cards", url.String())
+}
+
+func TestHostileIDsNeverReachAnotherPath(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+
+	_, err := f.FetchSingleCardCtx(context.Background(), "../sets/swsh3")
+	assert.NoError(t, err)
+	_, err = f.GetCardBySetAndLocalIdCtx(context.Background(), "swsh3/136", "?x")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/en/cards/..%2Fsets%2Fswsh3", "/en/sets/swsh3%2F136/%3Fx"}, paths)
+
+	_, err = f.GetSetsCtx(context.Background(), "..")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = f.GetSingleSerieCtx(context.Background(), "")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = f.GetCardsByIllustrator(context.Background(), "a\nb")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	assert.Len(t, paths, 2)
+}
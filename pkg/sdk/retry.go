@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures retries of idempotent requests that failed with a
+// retryable status or a transient transport error.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	BaseBackoff time.Duration
+	// MaxBackoff bounds every wait, Retry-After included. Zero means no
+	// bound.
+	MaxBackoff time.Duration
+	// Jitter randomly shortens each backoff by up to this fraction (0 to 1).
+	Jitter            float64
+	RetryableStatuses []int
+	// RetryableError reports whether a transport error is retried. Defaults
+	// to connection resets, unexpected EOFs and network timeouts.
+	RetryableError func(err error) bool
+	// OnRetry is called before waiting for the next attempt.
+	OnRetry func(event RetryEvent)
+}
+
+type RetryEvent struct {
+	// Attempt is the attempt that failed, starting at 1.
+	Attempt    int
+	Method     string
+	URL        string
+	StatusCode int
+	Err        error
+	Wait       time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 200 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetry enables retries. Requests are not retried by default.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *config) {
+		c.retry = &policy
+	}
+}
+
+func (p *RetryPolicy) retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		if p.RetryableError != nil {
+			return p.RetryableError(err)
+		}
+		return isTransientError(err)
+	}
+
+	return slices.Contains(p.RetryableStatuses, resp.StatusCode)
+}
+
+// backoff returns the wait before the attempt following attempt, honoring a
+// Retry-After header when the server sent one. Retry-After is capped by
+// MaxBackoff too, so a server asking for an hour cannot block the caller.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if p.MaxBackoff > 0 {
+				wait = min(wait, p.MaxBackoff)
+			}
+			return wait
+		}
+	}
+
+	wait := p.BaseBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait -= time.Duration(rand.Float64() * p.Jitter * float64(wait))
+	}
+
+	return wait
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type retryRecorder struct {
+	mu     sync.Mutex
+	events []RetryEvent
+}
+
+func (r *retryRecorder) policy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	policy.OnRetry = func(event RetryEvent) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.events = append(r.events, event)
+	}
+
+	return policy
+}
+
+func (r *retryRecorder) recorded() []RetryEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]RetryEvent(nil), r.events...)
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`["Fire"]`))
+	}))
+	defer srv.Close()
+
+	retries := &retryRecorder{}
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRetry(retries.policy()))
+	cardTypes, err := f.ListCardTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Fire"}, cardTypes)
+	assert.Equal(t, int32(3), calls.Load())
+
+	events := retries.recorded()
+	assert.Len(t, events, 2)
+	assert.Equal(t, 1, events[0].Attempt)
+	assert.Equal(t, http.StatusServiceUnavailable, events[0].StatusCode)
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	retries := &retryRecorder{}
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRetry(retries.policy()))
+	_, err := f.ListCardTypes()
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Len(t, retries.recorded(), 2)
+}
+
+func TestRetrySkipsNotFound(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	retries := &retryRecorder{}
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRetry(retries.policy()))
+	_, err := f.FetchSingleCard("swsh3-136")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, retries.recorded())
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`["Fire"]`))
+	}))
+	defer srv.Close()
+
+	retries := &retryRecorder{}
+	policy := retries.policy()
+	policy.MaxBackoff = 5 * time.Second
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRetry(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := f.ListCardTypesCtx(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Eventually(t, func() bool {
+		return len(retries.recorded()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, time.Second, retries.recorded()[0].Wait)
+}
+
+func TestRetryAfterCappedByMaxBackoff(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`["Fire"]`))
+	}))
+	defer srv.Close()
+
+	retries := &retryRecorder{}
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL), WithRetry(retries.policy()))
+
+	cardTypes, err := f.ListCardTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Fire"}, cardTypes)
+	assert.Equal(t, 10*time.Millisecond, retries.recorded()[0].Wait)
+
+	policy := RetryPolicy{MaxBackoff: 5 * time.Second}
+	resp := &http.Response{Header: http.Header{"Retry-After": {"3600"}}}
+	assert.Equal(t, 5*time.Second, policy.backoff(1, resp))
+
+	policy.MaxBackoff = 0
+	assert.Equal(t, time.Hour, policy.backoff(1, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 2, 10, 15, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, wait)
+
+	wait, ok = parseRetryAfter("Mon, 10 Feb 2025 15:00:30 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestRetryBackoffIsBounded(t *testing.T) {
+	policy := RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, nil))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, nil))
+	assert.Equal(t, time.Second, policy.backoff(10, nil))
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+const (
+	Asc  = model.SortAsc
+	Desc = model.SortDesc
+)
+
+var sortableFields = map[Endpoint][]string{
+	EndpointCards: {
+		"id", "localId", "name", "hp", "types", "rarity", "illustrator",
+		"category", "stage", "evolveFrom", "suffix", "regulationMark",
+		"retreat", "dexId", "trainerType", "energyType", "set.id",
+		"set.name",
+	},
+	EndpointSets: {
+		"id", "name", "releaseDate", "serie.id", "serie.name",
+		"cardCount.total", "cardCount.official",
+	},
+	EndpointSeries: {
+		"id", "name", "releaseDate",
+	},
+}
+
+// SortableFields returns the fields the search of endpoint can be sorted on.
+func SortableFields(endpoint Endpoint) []string {
+	return slices.Clone(sortableFields[endpoint])
+}
+
+func validateSort(endpoint Endpoint, sort model.Sort) error {
+	for _, key := range sort {
+		if !slices.Contains(sortableFields[endpoint], key.Field) {
+			return fmt.Errorf("%w: %s cannot be sorted by %q", ErrInvalidSort, endpoint, key.Field)
+		}
+
+		switch key.Order {
+		case "", model.SortAsc, model.SortDesc:
+		default:
+			return fmt.Errorf("%w: unknown order %q", ErrInvalidSort, key.Order)
+		}
+	}
+
+	return nil
+}
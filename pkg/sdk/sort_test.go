@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yogyrahmawan/tcgdex-go-sdk/pkg/model"
+)
+
+func TestSearchCardsSorted(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchCards(model.CardQueryOptions{
+		Name: "pikachu",
+		Sort: model.Sort{{Field: "hp", Order: Desc}, {Field: "localId"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "name=pikachu&sort%3Afield=hp&sort%3Afield=localId&sort%3Aorder=DESC&sort%3Aorder=ASC", rawQuery)
+}
+
+func TestSearchSetsSorted(t *testing.T) {
+	var rawQuery string
+	srv := newQueryRecorder(&rawQuery)
+	defer srv.Close()
+
+	f := New(WithHTTPClient(srv.Client()), WithBaseURL(srv.URL))
+	_, err := f.SearchSets(model.SetQueryOptions{
+		Sort: model.Sort{{Field: "releaseDate", Order: Asc}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "sort%3Afield=releaseDate&sort%3Aorder=ASC", rawQuery)
+}
+
+func TestSearchInvalidSort(t *testing.T) {
+	f := New(WithBaseURL("http://127.0.0.1:0"))
+
+	_, err := f.SearchCards(model.CardQueryOptions{
+		Sort: model.Sort{{Field: "releaseDate"}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidSort)
+
+	_, err = f.SearchSeries(model.SerieQueryOptions{
+		Sort: model.Sort{{Field: "name", Order: "up"}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
+
+func TestSortableFields(t *testing.T) {
+	assert.Contains(t, SortableFields(EndpointCards), "localId")
+	assert.Contains(t, SortableFields(EndpointSets), "releaseDate")
+	assert.Empty(t, SortableFields(EndpointTypes))
+}